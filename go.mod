@@ -2,7 +2,10 @@ module github.com/DooomiT/assembly-ai-go
 
 go 1.19
 
-require github.com/stretchr/testify v1.8.1
+require (
+	github.com/gorilla/websocket v1.5.3
+	github.com/stretchr/testify v1.8.1
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
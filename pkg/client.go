@@ -2,45 +2,191 @@ package assemblyai
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"regexp"
 	"strconv"
 	"time"
 )
 
 type AssemblyAI interface {
-	// UploadLocalFile uploads binary data to AssemblyAI
+	// UploadLocalFile uploads binary data to AssemblyAI, use UploadFile to stream a file without reading it into memory
 	// It returs the upload_url
 	UploadLocalFile(content []byte) (string, error)
+	// UploadLocalFileFull uploads binary data to AssemblyAI
+	// It returns the upload url together with the time it was created
+	UploadLocalFileFull(content []byte) (*Upload, error)
+	// UploadReader streams the data of the reader to AssemblyAI
+	// It returs the upload_url
+	UploadReader(r io.Reader) (string, error)
+	// UploadFile uploads the file at the given path to AssemblyAI
+	// It returs the upload_url
+	UploadFile(path string) (string, error)
 	// Transcript creates a transcription job at AssemblyAI
 	// It returns the id of the job
 	Transcript(audioUrl string) (string, error)
+	// TranscriptWithOptions creates a transcription job at AssemblyAI using additional options
+	// It returns the id of the job
+	TranscriptWithOptions(audioUrl string, opts *TranscriptOptions) (string, error)
+	// TranscriptWithConfig creates a transcription job at AssemblyAI using the given config
+	// It returns the id of the job
+	TranscriptWithConfig(audioUrl string, cfg TranscriptConfig) (string, error)
 	// Transcript polls a transcription job at AssemblyAI
 	// It returns the result of the job
 	PollTranscript(id string, pollSettings *PollSettings) (string, error)
+	// PollTranscriptContext polls the transcription job like PollTranscript until it is completed or ctx is done
+	// It returns the transcribed text or ctx.Err() if ctx is done first
+	PollTranscriptContext(ctx context.Context, id string, pollSettings *PollSettings) (string, error)
+	// PollTranscriptAsync polls the transcription job like PollTranscriptContext in the background
+	// It returns a channel receiving the full response of the completed job or the error of polling
+	PollTranscriptAsync(ctx context.Context, id string, pollSettings *PollSettings) (<-chan TranscriptResult, error)
+	// TranscriptFull creates a transcription job at AssemblyAI
+	// It returns the full response of the job submission
+	TranscriptFull(audioUrl string) (*TranscriptResponse, error)
+	// PollTranscriptFull polls a transcription job at AssemblyAI
+	// It returns the full response of the completed job
+	PollTranscriptFull(id string, pollSettings *PollSettings) (*TranscriptResponse, error)
+	// GetTranscript fetches a transcription job at AssemblyAI once
+	// It returns the full response of the job in its current status
+	GetTranscript(id string) (*TranscriptResponse, error)
+	// GetTranscriptContext fetches a transcription job at AssemblyAI once like GetTranscript, the request is cancelled once ctx is done
+	// It returns the full response of the job in its current status
+	GetTranscriptContext(ctx context.Context, id string) (*TranscriptResponse, error)
+	// DeleteTranscript deletes a transcription job at AssemblyAI
+	// It removes the transcribed text and the uploaded audio
+	DeleteTranscript(id string) error
+	// GetUtterances fetches the speaker separated utterances of a completed transcription job
+	// It returns nil if speaker labels were not requested
+	GetUtterances(id string) ([]Utterance, error)
+	// GetWords fetches the words of a completed transcription job
+	// It returns the words including their timestamps and confidence
+	GetWords(id string) ([]Word, error)
+	// GetSummary fetches the summary of a completed transcription job
+	// It returns an empty string if summarization was not requested
+	GetSummary(id string) (string, error)
+	// GetChapters fetches the chapters of a completed transcription job
+	// It returns nil if auto chapters were not requested
+	GetChapters(id string) ([]Chapter, error)
+	// GetSentiment fetches the sentiment analysis results of a completed transcription job
+	// It returns nil if sentiment analysis was not requested
+	GetSentiment(id string) ([]SentimentResult, error)
+	// GetEntities fetches the detected entities of a completed transcription job
+	// It returns nil if entity detection was not requested
+	GetEntities(id string) ([]Entity, error)
+	// GetContentSafety fetches the content safety labels of a completed transcription job
+	// It returns nil if content safety detection was not requested
+	GetContentSafety(id string) (*ContentSafety, error)
+	// GetTopics fetches the detected topics of a completed transcription job
+	// It returns nil if topic detection was not requested
+	GetTopics(id string) (*TopicResult, error)
+	// GetParagraphs fetches the paragraphs of a completed transcription job
+	// It returns the paragraphs including their timestamps and words
+	GetParagraphs(id string) ([]Paragraph, error)
+	// GetSentences fetches the sentences of a completed transcription job
+	// It returns the sentences including their timestamps and words
+	GetSentences(id string) ([]Sentence, error)
+	// WordSearch searches a completed transcription job for words or phrases
+	// It returns the count, indexes and timestamps of the matches of each word
+	WordSearch(id string, words []string) (*WordSearchResult, error)
+	// GetSubtitles exports a completed transcription job as SRT or VTT subtitles
+	// It returns the content of the subtitle file
+	GetSubtitles(id string, format SubtitleFormat, charsPerCaption int) (string, error)
+	// GetRedactedAudio fetches the redacted audio of a transcription job
+	// It returns the redacted_audio_url or ErrRedactedAudioNotReady
+	GetRedactedAudio(id string) (*RedactedAudioResponse, error)
+	// DownloadRedactedAudio streams the redacted audio of a transcription job into w
+	// It returns ErrRedactedAudioNotReady if the redacted audio is not produced yet
+	DownloadRedactedAudio(id string, w io.Writer) error
+	// ListTranscripts lists the transcription jobs at AssemblyAI
+	// It returns a page of transcripts and the cursors of the adjacent pages
+	ListTranscripts(params ListParams) (*TranscriptList, error)
+	// ListAllTranscripts calls fn for every transcription job, following the pagination cursor until fn returns false
+	// It returns an error if a page can not be fetched or the context is cancelled
+	ListAllTranscripts(ctx context.Context, params ListParams, fn func(transcript TranscriptSummary) bool) error
+	// TranscribeFile uploads, submits and polls a local file
+	// It returns the transcribed text
+	TranscribeFile(path string, cfg *TranscriptConfig, poll *PollSettings) (string, error)
+	// TranscribeURL submits and polls a remote audio file
+	// It returns the full response of the completed job
+	TranscribeURL(audioUrl string, cfg *TranscriptConfig, poll *PollSettings) (*TranscriptResponse, error)
+	// TranscribeURLContext submits and polls a remote audio file like TranscribeURL until it is completed or ctx is done
+	// It returns the full response of the completed job or an error matching ctx.Err() if ctx is done first
+	TranscribeURLContext(ctx context.Context, audioUrl string, cfg *TranscriptConfig, poll *PollSettings) (*TranscriptResponse, error)
+	// TranscribeBatch transcribes multiple remote audio files with a bounded number of concurrent jobs
+	// It returns a result for every audio url in the order of urls
+	TranscribeBatch(urls []string, cfg *TranscriptConfig, concurrency int) ([]BatchResult, error)
+	// TranscribeBatchContext transcribes multiple remote audio files like TranscribeBatch until they are completed or ctx is done
+	// It returns a result for every audio url in the order of urls, the urls not completed before ctx is done fail with ctx.Err()
+	TranscribeBatchContext(ctx context.Context, urls []string, cfg *TranscriptConfig, concurrency int) ([]BatchResult, error)
+	// ValidateToken checks the token with a lightweight authenticated request
+	// It returns an error matching ErrInvalidToken if AssemblyAI rejects the token
+	ValidateToken() error
+	// CreateRealtimeToken creates a temporary token for the realtime transcription valid for expiresIn
+	// It returns the token to connect a RealtimeClient with WithTemporaryToken
+	CreateRealtimeToken(expiresIn time.Duration) (string, error)
+	// LemurTask runs a custom prompt over completed transcription jobs with LeMUR
+	// It returns the generated response and the consumed tokens
+	LemurTask(req LemurTaskRequest) (*LemurResponse, error)
+	// LemurSummary summarizes completed transcription jobs with LeMUR
+	// It returns the summary and the consumed tokens
+	LemurSummary(req LemurSummaryRequest) (*LemurResponse, error)
+	// LemurQuestionAnswer answers questions about completed transcription jobs with LeMUR
+	// It returns an answer for every question and the consumed tokens
+	LemurQuestionAnswer(req LemurQARequest) (*LemurQAResponse, error)
 }
 
+// AssemblyAImpl is safe for concurrent use by multiple goroutines.
+// Its configuration is set on creation and never modified afterwards, the embedded http.Client is safe for concurrent use as well.
 type AssemblyAImpl struct {
 	http.Client
-	baseUrl string
-	token   string
+	baseUrl             string
+	token               string
+	userAgent           string
+	defaultPollSettings *PollSettings
 }
 
 // Creates a new AssemblyAI client.
 // baseUrl is the base api url of AssemblyAI e.g. "https://api.AssemblyAI.com/v2".
 // token is your AssemblyAI api token.
 // client lets you configure your own http client to use, by default it uses the basic go http.Client with a 15 seconds timeout.
+// Use a RetryTransport as the transport of client to retry network errors and rate limited requests.
 func New(baseUrl, token string, client *http.Client) AssemblyAI {
 	if client == nil {
 		client = &http.Client{
 			Timeout: time.Second * 15,
 		}
 	}
-	return &AssemblyAImpl{*client, baseUrl, token}
+	return &AssemblyAImpl{Client: *client, baseUrl: baseUrl, token: token, userAgent: DefaultUserAgent}
+}
+
+// Checks the token by listing a single transcript, as AssemblyAI has no dedicated account endpoint.
+// Returns an APIError matching ErrInvalidToken if the token is rejected
+func (client *AssemblyAImpl) ValidateToken() error {
+	_, err := client.ListTranscripts(ListParams{Limit: 1})
+	return err
+}
+
+// newRequest creates a request to AssemblyAI including the authorization and user agent headers.
+func (client *AssemblyAImpl) newRequest(method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("authorization", client.token)
+	req.Header.Set("User-Agent", client.userAgent)
+	return req, nil
+}
+
+// Sends the request like http.Client.Do, the token of the client is removed from the message of a returned error.
+// Returns the response of AssemblyAI
+func (client *AssemblyAImpl) Do(req *http.Request) (*http.Response, error) {
+	resp, err := client.Client.Do(req)
+	return resp, redactError(err, client.token)
 }
 
 func isValidStatus(statusCode int) bool {
@@ -49,14 +195,64 @@ func isValidStatus(statusCode int) bool {
 	return okStatusRegex.MatchString(s)
 }
 
+// maxErrorBodySize limits how much of an error response is read, error pages of proxies can be large.
+const maxErrorBodySize = 64 * 1024
+
 func getBody(response *http.Response) ([]byte, error) {
-	body, err := io.ReadAll(response.Body)
+	reader := response.Body
+	if !isValidStatus(response.StatusCode) {
+		reader = io.NopCloser(io.LimitReader(response.Body, maxErrorBodySize))
+	}
+	body, err := io.ReadAll(reader)
 	if err != nil {
 		return nil, err
 	}
+	// a response never contains the token legitimately, it is removed in case it is echoed into an error message
+	if response.Request != nil {
+		body = redactTokenBytes(body, response.Request.Header.Get("authorization"))
+	}
 	return body, err
 }
 
+// APIError is returned if AssemblyAI responds with a non 2xx status code.
+type APIError struct {
+	StatusCode int
+	// Message is the error message of the response or the raw body if it did not contain one
+	Message string
+	Body    []byte
+	// RetryAfter is the wait requested by the Retry-After header of the response, it is zero if the header is missing
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("assemblyai responded with status %d: %s", e.StatusCode, e.Message)
+}
+
+// ErrInvalidToken matches every APIError with status 401, which AssemblyAI returns for a missing or invalid token.
+var ErrInvalidToken = errors.New("invalid assemblyai token")
+
+// Is reports whether the error matches target, it lets errors.Is(err, ErrInvalidToken) detect a 401 response.
+func (e *APIError) Is(target error) bool {
+	return target == ErrInvalidToken && e.StatusCode == http.StatusUnauthorized
+}
+
+func newAPIError(statusCode int, body []byte) *APIError {
+	var data struct {
+		Error string `json:"error"`
+	}
+	message := string(body)
+	if err := json.Unmarshal(body, &data); err == nil && data.Error != "" {
+		message = data.Error
+	}
+	return &APIError{StatusCode: statusCode, Message: message, Body: body}
+}
+
+// ErrEmptyResponse is returned if AssemblyAI responds with a 2xx status code but without a body.
+var ErrEmptyResponse = errors.New("assemblyai responded with an empty body")
+
+// maxDecodeErrorBodySize limits the part of the body included in the error of a response which is not valid JSON.
+const maxDecodeErrorBodySize = 200
+
 func getData[T any](response *http.Response) (*T, error) {
 	body, err := getBody(response)
 	if err != nil {
@@ -64,13 +260,21 @@ func getData[T any](response *http.Response) (*T, error) {
 	}
 
 	if !isValidStatus(response.StatusCode) {
-		return nil, fmt.Errorf(string(body))
+		apiError := newAPIError(response.StatusCode, body)
+		apiError.RetryAfter, _ = parseRetryAfterHeader(response.Header.Get("Retry-After"))
+		return nil, apiError
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, fmt.Errorf("%w, status %d", ErrEmptyResponse, response.StatusCode)
 	}
 
 	var data T
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return nil, err
+		if len(body) > maxDecodeErrorBodySize {
+			body = append(body[:maxDecodeErrorBodySize:maxDecodeErrorBodySize], "..."...)
+		}
+		return nil, fmt.Errorf("could not decode response with status %d: %w, body: %q", response.StatusCode, err, body)
 	}
 	return &data, nil
 }
@@ -79,118 +283,322 @@ type UploadLocalFileResponse struct {
 	UploadUrl string `json:"upload_url"`
 }
 
+// UploadTTL is the time an upload url can be used to submit a transcription job, AssemblyAI deletes uploaded files after 24 hours.
+const UploadTTL = time.Hour * 24
+
+// Upload is an uploaded file, its URL can only be transcribed until it is expired.
+type Upload struct {
+	URL string
+	// CreatedAt is the time the upload was started, it is set before the request is sent so the expiry is never underestimated
+	CreatedAt time.Time
+}
+
+// Expired reports whether the upload is older than UploadTTL and its url can no longer be transcribed.
+func (u Upload) Expired() bool {
+	return time.Since(u.CreatedAt) >= UploadTTL
+}
+
 // Uploads the content to AssemblyAI following the AssemblyAI documentation https://www.AssemblyAI.com/docs/walkthroughs#uploading-local-files-for-transcription.
+// Use UploadFile instead of reading a local file with os.ReadFile first, it streams the file from its path.
 // Returns the upload_url
 func (client *AssemblyAImpl) UploadLocalFile(content []byte) (string, error) {
-	req, err := http.NewRequest("POST", client.baseUrl+"/upload", bytes.NewBuffer(content))
+	return client.UploadReader(bytes.NewReader(content))
+}
+
+// Uploads the content to AssemblyAI like UploadLocalFile.
+// Returns the upload_url and the time of the upload to check whether it is expired before it is transcribed
+func (client *AssemblyAImpl) UploadLocalFileFull(content []byte) (*Upload, error) {
+	return client.upload(bytes.NewReader(content))
+}
+
+// Streams the content of the reader to AssemblyAI like UploadLocalFile, without reading it into memory first.
+// The body is sent chunked, so the size of the content does not need to be known.
+// Returns the upload_url
+func (client *AssemblyAImpl) UploadReader(r io.Reader) (string, error) {
+	upload, err := client.upload(r)
 	if err != nil {
 		return "", err
 	}
+	return upload.URL, nil
+}
+
+func (client *AssemblyAImpl) upload(r io.Reader) (*Upload, error) {
+	req, err := client.newRequest("POST", client.baseUrl+"/upload", r)
+	if err != nil {
+		return nil, err
+	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("authorization", client.token)
 	req.Header.Set("transfer-encoding", "chunked")
+	createdAt := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 	data, err := getData[UploadLocalFileResponse](resp)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	if data.UploadUrl == "" {
+		return nil, errors.New("response did not include an upload_url")
+	}
+	return &Upload{URL: data.UploadUrl, CreatedAt: createdAt}, nil
+}
+
+// Uploads the file at path to AssemblyAI like UploadReader, the file is streamed and closed afterwards.
+// Errors opening or reading the file are wrapped in a FileError to distinguish them from http errors.
+// Returns the upload_url
+func (client *AssemblyAImpl) UploadFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", &FileError{Path: path, Err: err}
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return "", &FileError{Path: path, Err: err}
+	}
+	if info.IsDir() {
+		return "", &FileError{Path: path, Err: errors.New("is a directory")}
 	}
-	return data.UploadUrl, nil
+	return client.UploadReader(file)
 }
 
-type TranscriptResponse struct {
-	Id     string `json:"id"`
-	Status string `json:"status"`
-	Text   string `json:"text"`
-	Error  string `json:"error"`
+// FileError is returned if a local file could not be opened or read.
+type FileError struct {
+	Path string
+	Err  error
 }
 
-type PollSettings struct {
-	frequency time.Duration
-	timeout   time.Duration
+func (e *FileError) Error() string {
+	return fmt.Sprintf("could not read file %s: %s", e.Path, e.Err)
 }
 
-type TranscriptionStatus string
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
 
-const (
-	Err       TranscriptionStatus = "error"
-	Queued                        = "queued"
-	Completed                     = "completed"
-)
+// ErrTranscriptNotCompleted is returned when a result of a transcription job is requested before the job is completed.
+var ErrTranscriptNotCompleted = errors.New("transcript is not completed")
+
+// Fetches the current state of the transcription job once, without waiting for it to complete.
+// Returns the full transcript response in whatever status the job is
+func (client *AssemblyAImpl) GetTranscript(id string) (*TranscriptResponse, error) {
+	return client.getTranscript(context.Background(), id)
+}
 
-// Polls the transcription job based on a id.
-// Optionally you can provide pollSettings to define the poll frequency and timeout
-// pollSettings.frequency defines the poll frequency and defaults to 5 seconds
-// pollSettings.timeout defines the maximum polling time and defaults to 1 minute
-// returns the transcribed text if the status is completed
-func (client *AssemblyAImpl) PollTranscript(id string, pollSettings *PollSettings) (string, error) {
-	if pollSettings == nil {
-		pollSettings = &PollSettings{frequency: time.Second * 5, timeout: time.Minute}
+// Fetches the current state of the transcription job once like GetTranscript, the request is cancelled once ctx is done.
+// Returns the full transcript response in whatever status the job is or ctx.Err() if ctx is done first
+func (client *AssemblyAImpl) GetTranscriptContext(ctx context.Context, id string) (*TranscriptResponse, error) {
+	data, err := client.getTranscript(ctx, id)
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return data, err
+}
+
+func (client *AssemblyAImpl) getTranscript(ctx context.Context, id string) (*TranscriptResponse, error) {
+	url := fmt.Sprintf("%s/transcript/%s", client.baseUrl, id)
+	req, err := client.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
+	return getData[TranscriptResponse](resp)
+}
+
+// Deletes the transcription job following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/transcript#delete-transcript.
+// AssemblyAI removes the transcribed text and the uploaded audio of the job
+func (client *AssemblyAImpl) DeleteTranscript(id string) error {
 	url := fmt.Sprintf("%s/transcript/%s", client.baseUrl, id)
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := client.newRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = getData[TranscriptResponse](resp)
+	return err
+}
+
+// getCompletedTranscript fetches the transcription job and returns an error if it is not completed.
+func (client *AssemblyAImpl) getCompletedTranscript(id string) (*TranscriptResponse, error) {
+	data, err := client.GetTranscript(id)
+	if err != nil {
+		return nil, err
+	}
+	switch data.Status {
+	case Completed:
+		return data, nil
+	case Err:
+		return nil, data.err()
+	default:
+		return nil, fmt.Errorf("%w, status is %s", ErrTranscriptNotCompleted, data.Status)
+	}
+}
+
+// notCompletedError replaces a 400 response of an endpoint which requires a completed job by ErrTranscriptNotCompleted,
+// if the job is in fact not completed yet. Any other error is returned unchanged.
+func (client *AssemblyAImpl) notCompletedError(id string, err error) error {
+	var apiError *APIError
+	if !errors.As(err, &apiError) || apiError.StatusCode != http.StatusBadRequest {
+		return err
+	}
+	if _, statusErr := client.getCompletedTranscript(id); errors.Is(statusErr, ErrTranscriptNotCompleted) {
+		return statusErr
+	}
+	return err
+}
+
+// Fetches the utterances of a completed transcription job, which requires SpeakerLabels to be set on submission.
+// Returns nil if the job was submitted without speaker labels
+func (client *AssemblyAImpl) GetUtterances(id string) ([]Utterance, error) {
+	data, err := client.getCompletedTranscript(id)
+	if err != nil {
+		return nil, err
+	}
+	return data.Utterances, nil
+}
+
+// Fetches the words of a completed transcription job including their timestamps and confidence.
+// Returns the words in the order they are spoken
+func (client *AssemblyAImpl) GetWords(id string) ([]Word, error) {
+	data, err := client.getCompletedTranscript(id)
+	if err != nil {
+		return nil, err
+	}
+	return data.Words, nil
+}
+
+// Fetches the summary of a completed transcription job.
+// Returns the summary in the requested summary_type, empty if summarization was not enabled
+func (client *AssemblyAImpl) GetSummary(id string) (string, error) {
+	data, err := client.getCompletedTranscript(id)
 	if err != nil {
 		return "", err
 	}
-	req.Header.Set("authorization", client.token)
-	timeoutTime := time.Now().Add(pollSettings.timeout)
-	for time.Now().Before(timeoutTime) {
-		resp, err := client.Do(req)
-		if err != nil {
-			return "", err
-		}
-		data, err := getData[TranscriptResponse](resp)
-		if err != nil {
-			return "", err
-		}
-		switch TranscriptionStatus(data.Status) {
-		case Err:
-			return "", errors.New(data.Error)
-		case Completed:
-			return data.Text, nil
-		case Queued:
-			time.Sleep(pollSettings.frequency)
+	return data.Summary, nil
+}
 
-		}
+// Fetches the chapters of a completed transcription job including their headline, gist and timestamps.
+// Returns nil if auto_chapters was not enabled
+func (client *AssemblyAImpl) GetChapters(id string) ([]Chapter, error) {
+	data, err := client.getCompletedTranscript(id)
+	if err != nil {
+		return nil, err
+	}
+	return data.Chapters, nil
+}
+
+// Fetches the sentiment of every sentence of a completed transcription job.
+// Returns nil if sentiment_analysis was not enabled
+func (client *AssemblyAImpl) GetSentiment(id string) ([]SentimentResult, error) {
+	data, err := client.getCompletedTranscript(id)
+	if err != nil {
+		return nil, err
+	}
+	return data.SentimentAnalysisResults, nil
+}
+
+// Fetches the entities like names, locations and phone numbers detected in a completed transcription job.
+// Returns nil if entity_detection was not enabled
+func (client *AssemblyAImpl) GetEntities(id string) ([]Entity, error) {
+	data, err := client.getCompletedTranscript(id)
+	if err != nil {
+		return nil, err
+	}
+	return data.Entities, nil
+}
+
+// Fetches the content safety labels of a completed transcription job including the confidence and severity of each label.
+// Returns nil if content_safety was not enabled
+func (client *AssemblyAImpl) GetContentSafety(id string) (*ContentSafety, error) {
+	data, err := client.getCompletedTranscript(id)
+	if err != nil {
+		return nil, err
+	}
+	return data.ContentSafetyLabels, nil
+}
+
+// Fetches the IAB categories of a completed transcription job including the relevance of each topic.
+// Returns nil if iab_categories was not enabled
+func (client *AssemblyAImpl) GetTopics(id string) (*TopicResult, error) {
+	data, err := client.getCompletedTranscript(id)
+	if err != nil {
+		return nil, err
 	}
-	return "", fmt.Errorf("timeout, transcription not finished in %s", pollSettings.timeout)
+	return data.IABCategoriesResult, nil
 }
 
 type TranscriptDto struct {
 	AudioUrl string `json:"audio_url"`
+	*TranscriptOptions
 }
 
 // Submits a audio file for transcription follwing the AssemblyAI documentation https://www.AssemblyAI.com/docs/walkthroughs#submitting-files-for-transcription.
 // Returns the id of the transcription job
 func (client *AssemblyAImpl) Transcript(audioUrl string) (string, error) {
-	dto := TranscriptDto{AudioUrl: audioUrl}
-	body, err := json.Marshal(dto)
+	return client.TranscriptWithOptions(audioUrl, nil)
+}
+
+// Submits a audio file for transcription like Transcript, additionally sending the given options.
+// opts may be nil, in which case only the audio_url is sent.
+// Returns the id of the transcription job
+func (client *AssemblyAImpl) TranscriptWithOptions(audioUrl string, opts *TranscriptOptions) (string, error) {
+	data, err := client.submitTranscript(context.Background(), audioUrl, opts)
 	if err != nil {
 		return "", err
 	}
-	req, err := http.NewRequest("POST", client.baseUrl+"/transcript", bytes.NewBuffer(body))
+	return data.Id, nil
+}
+
+// Submits a audio file for transcription like TranscriptWithOptions, sending the fields of cfg alongside the audio_url.
+// Returns the id of the transcription job
+func (client *AssemblyAImpl) TranscriptWithConfig(audioUrl string, cfg TranscriptConfig) (string, error) {
+	return client.TranscriptWithOptions(audioUrl, &cfg)
+}
+
+// Submits a audio file for transcription like Transcript.
+// Returns the full response of the submitted transcription job
+func (client *AssemblyAImpl) TranscriptFull(audioUrl string) (*TranscriptResponse, error) {
+	return client.submitTranscript(context.Background(), audioUrl, nil)
+}
+
+func (client *AssemblyAImpl) submitTranscript(ctx context.Context, audioUrl string, opts *TranscriptOptions) (*TranscriptResponse, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	dto := TranscriptDto{AudioUrl: audioUrl, TranscriptOptions: opts}
+	body, err := json.Marshal(dto)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	req, err := client.newRequest("POST", client.baseUrl+"/transcript", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("authorization", client.token)
-	resp, err := client.Do(req)
+	resp, err := client.Do(req.WithContext(ctx))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 	data, err := getData[TranscriptResponse](resp)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if data.Id == "" {
-		return "", errors.New("response did not include an id")
+		return nil, errors.New("response did not include an id")
 	}
-	if data.Status == "error" {
-		return "", errors.New(data.Error)
+	if data.Status == Err {
+		return nil, data.err()
 	}
-	return data.Id, nil
+	return data, nil
 }
@@ -1,32 +1,276 @@
 package assemblyai
 
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrNotMocked is returned by the methods of AssemblyAIMock whose mock function is not set.
+var ErrNotMocked = errors.New("method is not mocked")
+
+// AssemblyAIMock implements AssemblyAI with the results of the mock functions, methods without one return ErrNotMocked.
 type AssemblyAIMock struct {
-	UploadLocalFileMock func() (string, error)
-	TranscriptMock      func() (string, error)
-	PollTranscriptMock  func() (string, error)
+	UploadLocalFileMock        func() (string, error)
+	UploadLocalFileFullMock    func() (*Upload, error)
+	UploadReaderMock           func() (string, error)
+	UploadFileMock             func() (string, error)
+	TranscriptMock             func() (string, error)
+	TranscriptWithOptionsMock  func() (string, error)
+	TranscriptWithConfigMock   func() (string, error)
+	PollTranscriptMock         func() (string, error)
+	PollTranscriptContextMock  func() (string, error)
+	PollTranscriptAsyncMock    func() (<-chan TranscriptResult, error)
+	TranscriptFullMock         func() (*TranscriptResponse, error)
+	PollTranscriptFullMock     func() (*TranscriptResponse, error)
+	GetTranscriptMock          func() (*TranscriptResponse, error)
+	GetTranscriptContextMock   func() (*TranscriptResponse, error)
+	DeleteTranscriptMock       func() error
+	GetUtterancesMock          func() ([]Utterance, error)
+	GetWordsMock               func() ([]Word, error)
+	GetSummaryMock             func() (string, error)
+	GetChaptersMock            func() ([]Chapter, error)
+	GetSentimentMock           func() ([]SentimentResult, error)
+	GetEntitiesMock            func() ([]Entity, error)
+	GetContentSafetyMock       func() (*ContentSafety, error)
+	GetTopicsMock              func() (*TopicResult, error)
+	GetParagraphsMock          func() ([]Paragraph, error)
+	GetSentencesMock           func() ([]Sentence, error)
+	WordSearchMock             func() (*WordSearchResult, error)
+	GetSubtitlesMock           func() (string, error)
+	GetRedactedAudioMock       func() (*RedactedAudioResponse, error)
+	DownloadRedactedAudioMock  func() error
+	ListTranscriptsMock        func() (*TranscriptList, error)
+	ListAllTranscriptsMock     func() error
+	TranscribeFileMock         func() (string, error)
+	TranscribeURLMock          func() (*TranscriptResponse, error)
+	TranscribeURLContextMock   func() (*TranscriptResponse, error)
+	TranscribeBatchMock        func() ([]BatchResult, error)
+	TranscribeBatchContextMock func() ([]BatchResult, error)
+	ValidateTokenMock          func() error
+	CreateRealtimeTokenMock    func() (string, error)
+	LemurTaskMock              func() (*LemurResponse, error)
+	LemurSummaryMock           func() (*LemurResponse, error)
+	LemurQuestionAnswerMock    func() (*LemurQAResponse, error)
 }
 
 func (client *AssemblyAIMock) UploadLocalFile(content []byte) (string, error) {
-	return client.UploadLocalFileMock()
+	return callMock(client.UploadLocalFileMock)
+}
+
+func (client *AssemblyAIMock) UploadLocalFileFull(content []byte) (*Upload, error) {
+	return callMock(client.UploadLocalFileFullMock)
+}
+
+func (client *AssemblyAIMock) UploadReader(r io.Reader) (string, error) {
+	return callMock(client.UploadReaderMock)
+}
+
+func (client *AssemblyAIMock) UploadFile(path string) (string, error) {
+	return callMock(client.UploadFileMock)
 }
 
 func (client *AssemblyAIMock) Transcript(audioUrl string) (string, error) {
-	return client.TranscriptMock()
+	return callMock(client.TranscriptMock)
+}
+
+func (client *AssemblyAIMock) TranscriptWithOptions(audioUrl string, opts *TranscriptOptions) (string, error) {
+	return callMock(client.TranscriptWithOptionsMock)
+}
+
+func (client *AssemblyAIMock) TranscriptWithConfig(audioUrl string, cfg TranscriptConfig) (string, error) {
+	return callMock(client.TranscriptWithConfigMock)
 }
 
 func (client *AssemblyAIMock) PollTranscript(id string, pollSettings *PollSettings) (string, error) {
-	return client.PollTranscriptMock()
+	return callMock(client.PollTranscriptMock)
 }
-func mockFunction(data string, err error) func() (string, error) {
-	return func() (string, error) {
+
+func (client *AssemblyAIMock) PollTranscriptContext(ctx context.Context, id string, pollSettings *PollSettings) (string, error) {
+	return callMock(client.PollTranscriptContextMock)
+}
+
+func (client *AssemblyAIMock) PollTranscriptAsync(ctx context.Context, id string, pollSettings *PollSettings) (<-chan TranscriptResult, error) {
+	return callMock(client.PollTranscriptAsyncMock)
+}
+
+func (client *AssemblyAIMock) TranscriptFull(audioUrl string) (*TranscriptResponse, error) {
+	return callMock(client.TranscriptFullMock)
+}
+
+func (client *AssemblyAIMock) PollTranscriptFull(id string, pollSettings *PollSettings) (*TranscriptResponse, error) {
+	return callMock(client.PollTranscriptFullMock)
+}
+
+func (client *AssemblyAIMock) GetTranscript(id string) (*TranscriptResponse, error) {
+	return callMock(client.GetTranscriptMock)
+}
+
+func (client *AssemblyAIMock) GetTranscriptContext(ctx context.Context, id string) (*TranscriptResponse, error) {
+	return callMock(client.GetTranscriptContextMock)
+}
+
+func (client *AssemblyAIMock) DeleteTranscript(id string) error {
+	return callErrorMock(client.DeleteTranscriptMock)
+}
+
+func (client *AssemblyAIMock) GetUtterances(id string) ([]Utterance, error) {
+	return callMock(client.GetUtterancesMock)
+}
+
+func (client *AssemblyAIMock) GetWords(id string) ([]Word, error) {
+	return callMock(client.GetWordsMock)
+}
+
+func (client *AssemblyAIMock) GetSummary(id string) (string, error) {
+	return callMock(client.GetSummaryMock)
+}
+
+func (client *AssemblyAIMock) GetChapters(id string) ([]Chapter, error) {
+	return callMock(client.GetChaptersMock)
+}
+
+func (client *AssemblyAIMock) GetSentiment(id string) ([]SentimentResult, error) {
+	return callMock(client.GetSentimentMock)
+}
+
+func (client *AssemblyAIMock) GetEntities(id string) ([]Entity, error) {
+	return callMock(client.GetEntitiesMock)
+}
+
+func (client *AssemblyAIMock) GetContentSafety(id string) (*ContentSafety, error) {
+	return callMock(client.GetContentSafetyMock)
+}
+
+func (client *AssemblyAIMock) GetTopics(id string) (*TopicResult, error) {
+	return callMock(client.GetTopicsMock)
+}
+
+func (client *AssemblyAIMock) GetParagraphs(id string) ([]Paragraph, error) {
+	return callMock(client.GetParagraphsMock)
+}
+
+func (client *AssemblyAIMock) GetSentences(id string) ([]Sentence, error) {
+	return callMock(client.GetSentencesMock)
+}
+
+func (client *AssemblyAIMock) WordSearch(id string, words []string) (*WordSearchResult, error) {
+	return callMock(client.WordSearchMock)
+}
+
+func (client *AssemblyAIMock) GetSubtitles(id string, format SubtitleFormat, charsPerCaption int) (string, error) {
+	return callMock(client.GetSubtitlesMock)
+}
+
+func (client *AssemblyAIMock) GetRedactedAudio(id string) (*RedactedAudioResponse, error) {
+	return callMock(client.GetRedactedAudioMock)
+}
+
+func (client *AssemblyAIMock) DownloadRedactedAudio(id string, w io.Writer) error {
+	return callErrorMock(client.DownloadRedactedAudioMock)
+}
+
+func (client *AssemblyAIMock) ListTranscripts(params ListParams) (*TranscriptList, error) {
+	return callMock(client.ListTranscriptsMock)
+}
+
+func (client *AssemblyAIMock) ListAllTranscripts(ctx context.Context, params ListParams, fn func(transcript TranscriptSummary) bool) error {
+	return callErrorMock(client.ListAllTranscriptsMock)
+}
+
+func (client *AssemblyAIMock) TranscribeFile(path string, cfg *TranscriptConfig, poll *PollSettings) (string, error) {
+	return callMock(client.TranscribeFileMock)
+}
+
+func (client *AssemblyAIMock) TranscribeURL(audioUrl string, cfg *TranscriptConfig, poll *PollSettings) (*TranscriptResponse, error) {
+	return callMock(client.TranscribeURLMock)
+}
+
+func (client *AssemblyAIMock) TranscribeURLContext(ctx context.Context, audioUrl string, cfg *TranscriptConfig, poll *PollSettings) (*TranscriptResponse, error) {
+	return callMock(client.TranscribeURLContextMock)
+}
+
+func (client *AssemblyAIMock) TranscribeBatch(urls []string, cfg *TranscriptConfig, concurrency int) ([]BatchResult, error) {
+	return callMock(client.TranscribeBatchMock)
+}
+
+func (client *AssemblyAIMock) TranscribeBatchContext(ctx context.Context, urls []string, cfg *TranscriptConfig, concurrency int) ([]BatchResult, error) {
+	return callMock(client.TranscribeBatchContextMock)
+}
+
+func (client *AssemblyAIMock) ValidateToken() error {
+	return callErrorMock(client.ValidateTokenMock)
+}
+
+func (client *AssemblyAIMock) CreateRealtimeToken(expiresIn time.Duration) (string, error) {
+	return callMock(client.CreateRealtimeTokenMock)
+}
+
+func (client *AssemblyAIMock) LemurTask(req LemurTaskRequest) (*LemurResponse, error) {
+	return callMock(client.LemurTaskMock)
+}
+
+func (client *AssemblyAIMock) LemurSummary(req LemurSummaryRequest) (*LemurResponse, error) {
+	return callMock(client.LemurSummaryMock)
+}
+
+func (client *AssemblyAIMock) LemurQuestionAnswer(req LemurQARequest) (*LemurQAResponse, error) {
+	return callMock(client.LemurQuestionAnswerMock)
+}
+
+// callMock calls the mock function or returns ErrNotMocked if it is not set.
+func callMock[T any](mock func() (T, error)) (T, error) {
+	if mock == nil {
+		var zero T
+		return zero, ErrNotMocked
+	}
+	return mock()
+}
+
+// callErrorMock calls the mock function or returns ErrNotMocked if it is not set.
+func callErrorMock(mock func() error) error {
+	if mock == nil {
+		return ErrNotMocked
+	}
+	return mock()
+}
+
+func mockFunction[T any](data T, err error) func() (T, error) {
+	return func() (T, error) {
 		return data, err
 	}
 }
 
+func mockResponseFunction(response *TranscriptResponse, err error) func() (*TranscriptResponse, error) {
+	if err != nil {
+		return mockFunction[*TranscriptResponse](nil, err)
+	}
+	return mockFunction(response, nil)
+}
+
 func NewMock(uploadFileUrl string, uploadFileError error, transcribedText string, transcribedTextError error, pollText string, pollError error) AssemblyAI {
+	var upload *Upload
+	if uploadFileError == nil {
+		upload = &Upload{URL: uploadFileUrl, CreatedAt: time.Now()}
+	}
+	transcript := &TranscriptResponse{Text: pollText, Status: Completed}
 	return &AssemblyAIMock{
-		UploadLocalFileMock: mockFunction(uploadFileUrl, uploadFileError),
-		TranscriptMock:      mockFunction(transcribedText, transcribedTextError),
-		PollTranscriptMock:  mockFunction(pollText, pollError),
+		UploadLocalFileMock:       mockFunction(uploadFileUrl, uploadFileError),
+		UploadLocalFileFullMock:   mockFunction(upload, uploadFileError),
+		UploadReaderMock:          mockFunction(uploadFileUrl, uploadFileError),
+		UploadFileMock:            mockFunction(uploadFileUrl, uploadFileError),
+		TranscriptMock:            mockFunction(transcribedText, transcribedTextError),
+		TranscriptWithOptionsMock: mockFunction(transcribedText, transcribedTextError),
+		TranscriptWithConfigMock:  mockFunction(transcribedText, transcribedTextError),
+		PollTranscriptMock:        mockFunction(pollText, pollError),
+		PollTranscriptContextMock: mockFunction(pollText, pollError),
+		TranscriptFullMock:        mockResponseFunction(&TranscriptResponse{Id: transcribedText, Status: Queued}, transcribedTextError),
+		PollTranscriptFullMock:    mockResponseFunction(transcript, pollError),
+		GetTranscriptMock:         mockResponseFunction(transcript, pollError),
+		GetTranscriptContextMock:  mockResponseFunction(transcript, pollError),
+		TranscribeFileMock:        mockFunction(pollText, pollError),
+		TranscribeURLMock:         mockResponseFunction(transcript, pollError),
+		TranscribeURLContextMock:  mockResponseFunction(transcript, pollError),
 	}
 }
@@ -0,0 +1,46 @@
+package assemblyai
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMockImplementsEveryMethod(t *testing.T) {
+	mock := reflect.ValueOf(NewMock("https://some-url.com/upload", nil, "some-id", nil, "Hello", nil))
+	api := reflect.TypeOf((*AssemblyAI)(nil)).Elem()
+
+	for i := 0; i < api.NumMethod(); i++ {
+		method := mock.MethodByName(api.Method(i).Name)
+		t.Run(api.Method(i).Name, func(t *testing.T) {
+			args := make([]reflect.Value, method.Type().NumIn())
+			for j := range args {
+				args[j] = reflect.Zero(method.Type().In(j))
+			}
+			var results []reflect.Value
+			assert.NotPanics(t, func() { results = method.Call(args) })
+			err, _ := results[len(results)-1].Interface().(error)
+			if err != nil {
+				assert.ErrorIs(t, err, ErrNotMocked)
+			}
+		})
+	}
+}
+
+func TestNewMock(t *testing.T) {
+	errPoll := errors.New("poll failed")
+	client := NewMock("https://some-url.com/upload", nil, "some-id", nil, "Hello", errPoll)
+
+	upload, err := client.UploadLocalFileFull([]byte("some audio data"))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://some-url.com/upload", upload.URL)
+	_, err = client.GetTranscript("some-id")
+	assert.ErrorIs(t, err, errPoll)
+	_, err = client.TranscribeURL("https://some-url.com/some-id", nil, nil)
+	assert.ErrorIs(t, err, errPoll)
+	assert.ErrorIs(t, client.DeleteTranscript("some-id"), ErrNotMocked)
+	_, err = (&AssemblyAIMock{}).LemurTask(LemurTaskRequest{})
+	assert.ErrorIs(t, err, ErrNotMocked)
+}
@@ -0,0 +1,113 @@
+package assemblyai
+
+import (
+	"net/http"
+	"time"
+)
+
+// DefaultBaseUrl is the base api url of AssemblyAI used by NewClient.
+const DefaultBaseUrl = "https://api.assemblyai.com/v2"
+
+// Version is the version of this client library, it is part of the DefaultUserAgent.
+const Version = "0.1.0"
+
+// DefaultUserAgent is the User-Agent header sent with every request unless it is replaced by WithUserAgent.
+const DefaultUserAgent = "assembly-ai-go/" + Version
+
+type clientOptions struct {
+	baseUrl         string
+	httpClient      *http.Client
+	timeout         time.Duration
+	userAgent       string
+	userAgentSuffix string
+	pollSettings    *PollSettings
+	logger          func(event LogEvent)
+}
+
+// Option configures a client created by NewClient.
+type Option func(options *clientOptions)
+
+// WithBaseURL sets the base api url of AssemblyAI, it defaults to DefaultBaseUrl.
+func WithBaseURL(baseUrl string) Option {
+	return func(options *clientOptions) {
+		options.baseUrl = baseUrl
+	}
+}
+
+// WithHTTPClient sets the http client used for all requests, it defaults to a http.Client with a 15 seconds timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(options *clientOptions) {
+		options.httpClient = httpClient
+	}
+}
+
+// WithTimeout sets the timeout of every request, it overrides the timeout of a client set by WithHTTPClient.
+func WithTimeout(timeout time.Duration) Option {
+	return func(options *clientOptions) {
+		options.timeout = timeout
+	}
+}
+
+// WithUserAgent replaces the DefaultUserAgent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(options *clientOptions) {
+		options.userAgent = userAgent
+	}
+}
+
+// WithUserAgentSuffix appends an app specific suffix e.g. "my-app/1.0" to the User-Agent header.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(options *clientOptions) {
+		options.userAgentSuffix = suffix
+	}
+}
+
+// WithDefaultPollSettings sets the poll settings used when PollTranscript is called without poll settings.
+func WithDefaultPollSettings(pollSettings *PollSettings) Option {
+	return func(options *clientOptions) {
+		options.pollSettings = pollSettings
+	}
+}
+
+// WithLogger sets a logger called with the method, url, status code and duration of every request.
+// The authorization header is redacted from the logged headers. If the http client uses a RetryTransport, all attempts of a request are logged as a single event.
+func WithLogger(logger func(event LogEvent)) Option {
+	return func(options *clientOptions) {
+		options.logger = logger
+	}
+}
+
+// Creates a new AssemblyAI client configured by the given options.
+// token is your AssemblyAI api token.
+// Without options the client uses DefaultBaseUrl and a http.Client with a 15 seconds timeout.
+func NewClient(token string, opts ...Option) AssemblyAI {
+	options := clientOptions{baseUrl: DefaultBaseUrl, userAgent: DefaultUserAgent}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	userAgent := options.userAgent
+	if options.userAgentSuffix != "" {
+		userAgent += " " + options.userAgentSuffix
+	}
+	httpClient := http.Client{Timeout: time.Second * 15}
+	if options.httpClient != nil {
+		httpClient = *options.httpClient
+	}
+	if options.timeout > 0 {
+		httpClient.Timeout = options.timeout
+	}
+	if options.logger != nil {
+		base := httpClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		httpClient.Transport = &loggingTransport{base: base, logger: options.logger}
+	}
+	return &AssemblyAImpl{
+		Client:              httpClient,
+		baseUrl:             options.baseUrl,
+		token:               token,
+		userAgent:           userAgent,
+		defaultPollSettings: options.pollSettings,
+	}
+}
@@ -0,0 +1,158 @@
+package assemblyai
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClientDefaults(t *testing.T) {
+	client := NewClient("some-token").(*AssemblyAImpl)
+	assert.Equal(t, "https://api.assemblyai.com/v2", client.baseUrl)
+	assert.Equal(t, "some-token", client.token)
+	assert.Equal(t, time.Second*15, client.Timeout)
+	assert.Equal(t, "assembly-ai-go/"+Version, client.userAgent)
+	assert.Nil(t, client.defaultPollSettings)
+}
+
+func TestNewClientOptions(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+	pollSettings := &PollSettings{Frequency: time.Second}
+	client := NewClient("some-token",
+		WithBaseURL("https://api.eu.assemblyai.com/v2"),
+		WithHTTPClient(httpClient),
+		WithTimeout(time.Minute),
+		WithUserAgent("my-app/1.0"),
+		WithDefaultPollSettings(pollSettings),
+	).(*AssemblyAImpl)
+	assert.Equal(t, "https://api.eu.assemblyai.com/v2", client.baseUrl)
+	assert.Equal(t, time.Minute, client.Timeout)
+	assert.Equal(t, time.Second, httpClient.Timeout)
+	assert.Equal(t, "my-app/1.0", client.userAgent)
+	assert.Equal(t, pollSettings, client.defaultPollSettings)
+}
+
+func TestNewClientRequests(t *testing.T) {
+	var authorization, userAgent string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		authorization = req.Header.Get("authorization")
+		userAgent = req.Header.Get("User-Agent")
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+	})
+	defer server.Close()
+	client := NewClient("some-token", WithBaseURL(server.URL), WithUserAgent("my-app/1.0"))
+
+	_, err := client.Transcript("https://some-url.com/some-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "some-token", authorization)
+	assert.Equal(t, "my-app/1.0", userAgent)
+}
+
+func TestNewClientDefaultPollSettings(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+	})
+	defer server.Close()
+	client := NewClient("some-token",
+		WithBaseURL(server.URL),
+		WithDefaultPollSettings(&PollSettings{Frequency: time.Millisecond, Timeout: time.Millisecond * 50}),
+	)
+
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.Error(t, err)
+	assert.Greater(t, requests, 1)
+}
+
+func TestUserAgent(t *testing.T) {
+	var userAgent string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		userAgent = req.Header.Get("User-Agent")
+		res.WriteHeader(200)
+		res.Write([]byte(`{"upload_url": "https://cdn.assemblyai.com/upload/some-id"}`))
+	})
+	defer server.Close()
+
+	testCases := []struct {
+		name      string
+		client    AssemblyAI
+		userAgent string
+	}{
+		{"New", New(server.URL, "some-token", nil), "assembly-ai-go/" + Version},
+		{"NewClient", NewClient("some-token", WithBaseURL(server.URL)), "assembly-ai-go/" + Version},
+		{"suffix", NewClient("some-token", WithBaseURL(server.URL), WithUserAgentSuffix("my-app/1.0")), "assembly-ai-go/" + Version + " my-app/1.0"},
+		{"override", NewClient("some-token", WithBaseURL(server.URL), WithUserAgent("custom")), "custom"},
+		{"override with suffix", NewClient("some-token", WithBaseURL(server.URL), WithUserAgentSuffix("my-app/1.0"), WithUserAgent("custom")), "custom my-app/1.0"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := testCase.client.UploadLocalFile([]byte("some audio data"))
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.userAgent, userAgent)
+		})
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+	})
+	defer server.Close()
+	events := []LogEvent{}
+	client := NewClient("some-token", WithBaseURL(server.URL), WithLogger(func(event LogEvent) {
+		events = append(events, event)
+	}))
+
+	_, err := client.Transcript("https://some-url.com/some-id")
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "POST", events[0].Method)
+	assert.Equal(t, server.URL+"/transcript", events[0].URL)
+	assert.Equal(t, 200, events[0].StatusCode)
+	assert.Greater(t, events[0].Duration, time.Duration(0))
+	assert.Equal(t, "[REDACTED]", events[0].Header.Get("authorization"))
+	assert.Equal(t, "assembly-ai-go/"+Version, events[0].Header.Get("User-Agent"))
+	assert.NoError(t, events[0].Err)
+}
+
+func TestWithLoggerAPIError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(401)
+		res.Write([]byte(`{"error": "Authentication error, API token missing/invalid"}`))
+	})
+	defer server.Close()
+	events := []LogEvent{}
+	httpClient := &http.Client{}
+	client := NewClient("some-token", WithBaseURL(server.URL), WithHTTPClient(httpClient), WithLogger(func(event LogEvent) {
+		events = append(events, event)
+	}))
+
+	_, err := client.GetTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "GET", events[0].Method)
+	assert.Equal(t, 401, events[0].StatusCode)
+	assert.NotContains(t, events[0].Header.Get("authorization"), "some-token")
+	assert.Nil(t, httpClient.Transport)
+}
+
+func TestWithLoggerConnectionError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {})
+	server.Close()
+	events := []LogEvent{}
+	client := NewClient("some-token", WithBaseURL(server.URL), WithLogger(func(event LogEvent) {
+		events = append(events, event)
+	}))
+
+	_, err := client.GetTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.Error(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, 0, events[0].StatusCode)
+	assert.Error(t, events[0].Err)
+}
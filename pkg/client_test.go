@@ -1,9 +1,17 @@
 package assemblyai
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/stretchr/testify/assert"
@@ -42,6 +50,57 @@ func TestUploadLocalFile(t *testing.T) {
 	assert.Equal(t, "https://cdn.assemblyai.com/upload/f4932e0c-4f0a-40b8-8994-bdae0c0980fb", uploadUrl)
 }
 
+func TestUploadLocalFileFull(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"upload_url": "https://cdn.assemblyai.com/upload/f4932e0c-4f0a-40b8-8994-bdae0c0980fb"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	before := time.Now()
+	upload, err := client.UploadLocalFileFull([]byte("some audio data"))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.assemblyai.com/upload/f4932e0c-4f0a-40b8-8994-bdae0c0980fb", upload.URL)
+	assert.False(t, upload.CreatedAt.Before(before))
+	assert.False(t, upload.CreatedAt.After(time.Now()))
+	assert.False(t, upload.Expired())
+}
+
+func TestUploadLocalFileFullBadRequest(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(400)
+		res.Write([]byte(`{"error": "Upload failed"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	upload, err := client.UploadLocalFileFull([]byte("some audio data"))
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Nil(t, upload)
+}
+
+func TestUploadExpired(t *testing.T) {
+	testCases := []struct {
+		name      string
+		createdAt time.Time
+		expired   bool
+	}{
+		{"just uploaded", time.Now(), false},
+		{"one hour old", time.Now().Add(-time.Hour), false},
+		{"almost expired", time.Now().Add(-UploadTTL + time.Minute), false},
+		{"expired", time.Now().Add(-UploadTTL), true},
+		{"two days old", time.Now().Add(-time.Hour * 48), true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			upload := Upload{URL: "https://cdn.assemblyai.com/upload/some-id", CreatedAt: testCase.createdAt}
+			assert.Equal(t, testCase.expired, upload.Expired())
+		})
+	}
+}
+
 func TestUploadLocalFileBadRequest(t *testing.T) {
 	server := getServer(func(res http.ResponseWriter, req *http.Request) {
 		res.WriteHeader(400)
@@ -68,6 +127,163 @@ func TestUploadLocalFileBadContent(t *testing.T) {
 	assert.Equal(t, "", uploadUrl)
 }
 
+func TestUploadLocalFileNoUploadUrl(t *testing.T) {
+	for _, body := range []string{`{}`, `{"upload_url": ""}`, `{"upload_url": null}`} {
+		server := getServer(func(res http.ResponseWriter, req *http.Request) {
+			res.WriteHeader(200)
+			res.Write([]byte(body))
+		})
+		client := New(server.URL, "some-token", http.DefaultClient)
+
+		uploadUrl, err := client.UploadLocalFile([]byte{})
+		assert.EqualError(t, err, "response did not include an upload_url")
+		assert.Equal(t, "", uploadUrl)
+		server.Close()
+	}
+}
+
+func TestUploadLocalFileLargeErrorBody(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(502)
+		res.Write([]byte(strings.Repeat("x", maxErrorBodySize*2)))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.UploadLocalFile([]byte{})
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Len(t, apiError.Body, maxErrorBodySize)
+}
+
+func TestUploadReader(t *testing.T) {
+	var received []byte
+	var transferEncoding []string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		received, _ = io.ReadAll(req.Body)
+		transferEncoding = req.TransferEncoding
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"upload_url": "https://cdn.assemblyai.com/upload/f4932e0c-4f0a-40b8-8994-bdae0c0980fb"
+		  }`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	content := strings.Repeat("some audio data", 1000)
+	// io.MultiReader hides the length of the content like a file or network stream would
+	uploadUrl, err := client.UploadReader(io.MultiReader(strings.NewReader(content)))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.assemblyai.com/upload/f4932e0c-4f0a-40b8-8994-bdae0c0980fb", uploadUrl)
+	assert.Equal(t, content, string(received))
+	assert.Equal(t, []string{"chunked"}, transferEncoding)
+}
+
+func TestUploadReaderReadError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		io.ReadAll(req.Body)
+		res.WriteHeader(200)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	uploadUrl, err := client.UploadReader(iotest.ErrReader(errors.New("read failed")))
+	assert.Error(t, err)
+	assert.Equal(t, "", uploadUrl)
+}
+
+func TestUploadFile(t *testing.T) {
+	var received []byte
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		received, _ = io.ReadAll(req.Body)
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"upload_url": "https://cdn.assemblyai.com/upload/f4932e0c-4f0a-40b8-8994-bdae0c0980fb"
+		  }`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	path := filepath.Join(t.TempDir(), "audio.mp3")
+	assert.NoError(t, os.WriteFile(path, []byte("some audio data"), 0600))
+	uploadUrl, err := client.UploadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.assemblyai.com/upload/f4932e0c-4f0a-40b8-8994-bdae0c0980fb", uploadUrl)
+	assert.Equal(t, "some audio data", string(received))
+}
+
+func TestUploadFileNotFound(t *testing.T) {
+	called := false
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	uploadUrl, err := client.UploadFile(filepath.Join(t.TempDir(), "missing.mp3"))
+	var fileError *FileError
+	assert.ErrorAs(t, err, &fileError)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.Equal(t, "", uploadUrl)
+	assert.False(t, called)
+}
+
+func TestUploadFileDirectory(t *testing.T) {
+	called := false
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	dir := t.TempDir()
+	uploadUrl, err := client.UploadFile(dir)
+	var fileError *FileError
+	assert.ErrorAs(t, err, &fileError)
+	assert.Equal(t, dir, fileError.Path)
+	assert.EqualError(t, err, "could not read file "+dir+": is a directory")
+	assert.Equal(t, "", uploadUrl)
+	assert.False(t, called)
+}
+
+func TestUploadFileLarge(t *testing.T) {
+	var received []byte
+	var transferEncoding []string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		received, _ = io.ReadAll(req.Body)
+		transferEncoding = req.TransferEncoding
+		res.WriteHeader(200)
+		res.Write([]byte(`{"upload_url": "https://cdn.assemblyai.com/upload/f4932e0c-4f0a-40b8-8994-bdae0c0980fb"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	content := bytes.Repeat([]byte("some audio data "), 1<<16)
+	path := filepath.Join(t.TempDir(), "audio.wav")
+	assert.NoError(t, os.WriteFile(path, content, 0600))
+	_, err := client.UploadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, content, received)
+	assert.Equal(t, []string{"chunked"}, transferEncoding)
+}
+
+func TestUploadFileBadRequest(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(400)
+		res.Write([]byte(`{}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	path := filepath.Join(t.TempDir(), "audio.mp3")
+	assert.NoError(t, os.WriteFile(path, []byte("some audio data"), 0600))
+	uploadUrl, err := client.UploadFile(path)
+	var fileError *FileError
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &fileError))
+	assert.Equal(t, "", uploadUrl)
+}
+
 func TestTranscribe(t *testing.T) {
 	server := getServer(func(res http.ResponseWriter, req *http.Request) {
 		res.WriteHeader(200)
@@ -111,10 +327,54 @@ func TestTranscribeBadBody(t *testing.T) {
 	client := New(server.URL, "some-token", http.DefaultClient)
 
 	text, err := client.Transcript("https://some-url.com/some-id")
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrEmptyResponse)
+	assert.EqualError(t, err, "assemblyai responded with an empty body, status 200")
 	assert.Equal(t, "", text)
 }
 
+func TestTranscribeWhitespaceBody(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(201)
+		res.Write([]byte("\n  "))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.Transcript("https://some-url.com/some-id")
+	assert.ErrorIs(t, err, ErrEmptyResponse)
+	assert.ErrorContains(t, err, "status 201")
+}
+
+func TestTranscribeNonJSONBody(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte("<html>Bad Gateway</html>"))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.Transcript("https://some-url.com/some-id")
+	var syntaxError *json.SyntaxError
+	assert.ErrorAs(t, err, &syntaxError)
+	assert.NotErrorIs(t, err, ErrEmptyResponse)
+	assert.ErrorContains(t, err, "status 200")
+	assert.ErrorContains(t, err, `body: "<html>Bad Gateway</html>"`)
+}
+
+func TestTranscribeLargeNonJSONBody(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(strings.Repeat("a", 300) + strings.Repeat("b", 100)))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.Transcript("https://some-url.com/some-id")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `body: "`+strings.Repeat("a", 200)+`..."`)
+	assert.NotContains(t, err.Error(), "bbb")
+}
+
 func TestTranscribeNoId(t *testing.T) {
 	server := getServer(func(res http.ResponseWriter, req *http.Request) {
 		res.WriteHeader(200)
@@ -193,8 +453,10 @@ func TestPollTranscribeTimeout(t *testing.T) {
 	defer server.Close()
 	client := New(server.URL, "some-token", http.DefaultClient)
 
-	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{timeout: time.Millisecond})
-	assert.Error(t, err)
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{Frequency: time.Millisecond, Timeout: time.Millisecond})
+	assert.ErrorIs(t, err, ErrPollTimeout)
+	assert.EqualError(t, err, "transcription polling timed out after 1ms, last status queued")
+	assert.NotErrorIs(t, err, ErrTranscriptFailed)
 	assert.Equal(t, "", text)
 }
 
@@ -214,8 +476,295 @@ func TestPollTranscribeBadHttpStatus(t *testing.T) {
 		defer server.Close()
 		client := New(server.URL, "some-token", http.DefaultClient)
 
-		text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{timeout: time.Millisecond})
+		text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{Frequency: time.Millisecond, Timeout: time.Millisecond})
 		assert.Error(t, err)
 		assert.Equal(t, "", text)
 	}
 }
+
+func TestPollSettingsDefaults(t *testing.T) {
+	var nilSettings *PollSettings
+	settings := nilSettings.withDefaults()
+	assert.Equal(t, time.Second*5, settings.Frequency)
+	assert.Equal(t, time.Minute, settings.Timeout)
+
+	settings = (&PollSettings{Timeout: time.Second}).withDefaults()
+	assert.Equal(t, time.Second*5, settings.Frequency)
+	assert.Equal(t, time.Second, settings.Timeout)
+
+	settings = (&PollSettings{Frequency: time.Millisecond}).withDefaults()
+	assert.Equal(t, time.Millisecond, settings.Frequency)
+	assert.Equal(t, time.Minute, settings.Timeout)
+
+	settings = (&PollSettings{MaxAttempts: 20}).withDefaults()
+	assert.Equal(t, time.Duration(0), settings.Timeout)
+}
+
+func TestTranscriptFull(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "queued",
+			"text": null
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.TranscriptFull("https://some-url.com/some-id")
+	assert.NoError(t, err)
+	assert.Equal(t, &TranscriptResponse{Id: "5551722-f677-48a6-9287-39c0aafd9ac1", Status: "queued"}, data)
+}
+
+func TestTranscriptFullNoId(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"status": "queued"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.TranscriptFull("https://some-url.com/some-id")
+	assert.Error(t, err)
+	assert.Nil(t, data)
+}
+
+func TestPollTranscriptFull(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "completed",
+			"text": "You know Demons on TV like that."
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.PollTranscriptFull("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &TranscriptResponse{
+		Id:     "5551722-f677-48a6-9287-39c0aafd9ac1",
+		Status: "completed",
+		Text:   "You know Demons on TV like that.",
+	}, data)
+}
+
+func TestPollTranscriptFullError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "error",
+			"error": "Download error"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.PollTranscriptFull("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.EqualError(t, err, "Download error")
+	assert.ErrorIs(t, err, ErrTranscriptFailed)
+	assert.NotErrorIs(t, err, ErrPollTimeout)
+	assert.Nil(t, data)
+}
+
+func TestAPIError(t *testing.T) {
+	testCases := []struct {
+		statusCode int
+		body       string
+		message    string
+	}{
+		{401, `{"error": "Authentication error, API token missing/invalid"}`, "Authentication error, API token missing/invalid"},
+		{429, `{"error": "Too many requests"}`, "Too many requests"},
+		{500, `Internal Server Error 100%`, "Internal Server Error 100%"},
+	}
+	for _, testCase := range testCases {
+		server := getServer(func(res http.ResponseWriter, req *http.Request) {
+			res.WriteHeader(testCase.statusCode)
+			res.Write([]byte(testCase.body))
+		})
+		client := New(server.URL, "some-token", http.DefaultClient)
+
+		_, err := client.Transcript("https://some-url.com/some-id")
+		var apiError *APIError
+		assert.ErrorAs(t, err, &apiError)
+		assert.Equal(t, testCase.statusCode, apiError.StatusCode)
+		assert.Equal(t, testCase.message, apiError.Message)
+		assert.Equal(t, testCase.body, string(apiError.Body))
+		assert.Contains(t, err.Error(), testCase.message)
+		server.Close()
+	}
+}
+
+func TestGetTranscript(t *testing.T) {
+	requests := 0
+	var path string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		path = req.URL.Path
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "processing",
+			"text": null
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.NoError(t, err)
+	assert.Equal(t, &TranscriptResponse{Id: "5551722-f677-48a6-9287-39c0aafd9ac1", Status: "processing"}, data)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, "/transcript/5551722-f677-48a6-9287-39c0aafd9ac1", path)
+}
+
+func TestGetTranscriptNotFound(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(404)
+		res.Write([]byte(`{"error": "Transcript not found"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 404, apiError.StatusCode)
+	assert.Nil(t, data)
+}
+
+func TestGetTranscriptStatuses(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected *TranscriptResponse
+	}{
+		{
+			"completed",
+			`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed", "text": "Hello world", "words": [{"text": "Hello", "start": 10, "end": 250, "confidence": 0.98}]}`,
+			&TranscriptResponse{
+				Id:     "5551722-f677-48a6-9287-39c0aafd9ac1",
+				Status: "completed",
+				Text:   "Hello world",
+				Words:  []Word{{Text: "Hello", Start: 10, End: 250, Confidence: 0.98}},
+			},
+		},
+		{
+			"queued",
+			`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued", "text": null}`,
+			&TranscriptResponse{Id: "5551722-f677-48a6-9287-39c0aafd9ac1", Status: "queued"},
+		},
+		{
+			"error",
+			`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "error", "error": "Download error, unable to download https://some-url.com/some-id"}`,
+			&TranscriptResponse{
+				Id:     "5551722-f677-48a6-9287-39c0aafd9ac1",
+				Status: "error",
+				Error:  "Download error, unable to download https://some-url.com/some-id",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var authorization string
+			server := getServer(func(res http.ResponseWriter, req *http.Request) {
+				authorization = req.Header.Get("authorization")
+				res.WriteHeader(200)
+				res.Write([]byte(testCase.body))
+			})
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			data, err := client.GetTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, data)
+			assert.Equal(t, "some-token", authorization)
+		})
+	}
+}
+
+func TestDeleteTranscript(t *testing.T) {
+	var method, path, authorization string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		method = req.Method
+		path = req.URL.Path
+		authorization = req.Header.Get("authorization")
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "completed",
+			"text": "Deleted by user.",
+			"audio_url": "http://deleted_by_user"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	err := client.DeleteTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE", method)
+	assert.Equal(t, "/transcript/5551722-f677-48a6-9287-39c0aafd9ac1", path)
+	assert.Equal(t, "some-token", authorization)
+}
+
+func TestDeleteTranscriptBadRequest(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(400)
+		res.Write([]byte(`{"error": "Transcript is still processing"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	err := client.DeleteTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 400, apiError.StatusCode)
+}
+
+func TestValidateToken(t *testing.T) {
+	var path, authorization string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.String()
+		authorization = req.Header.Get("authorization")
+		res.WriteHeader(200)
+		res.Write([]byte(`{"page_details": {"limit": 1, "result_count": 0}, "transcripts": []}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	err := client.ValidateToken()
+	assert.NoError(t, err)
+	assert.Equal(t, "/transcript?limit=1", path)
+	assert.Equal(t, "some-token", authorization)
+}
+
+func TestValidateTokenUnauthorized(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(401)
+		res.Write([]byte(`{"error": "Authentication error, API token missing/invalid"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	err := client.ValidateToken()
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, "Authentication error, API token missing/invalid", apiError.Message)
+}
+
+func TestValidateTokenServerError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(500)
+		res.Write([]byte(`{"error": "Internal server error"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	err := client.ValidateToken()
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidToken)
+}
@@ -0,0 +1,164 @@
+package assemblyai
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// LemurTaskRequest runs a custom prompt over the transcripts with LeMUR.
+type LemurTaskRequest struct {
+	// TranscriptIDs are the ids of the completed transcription jobs the prompt is run over
+	TranscriptIDs []string `json:"transcript_ids"`
+	Prompt        string   `json:"prompt"`
+	// FinalModel is the model generating the response, an empty value uses the default model of AssemblyAI
+	FinalModel string `json:"final_model,omitempty"`
+	// MaxOutputSize limits the number of tokens of the response, a zero value uses the default of AssemblyAI
+	MaxOutputSize int `json:"max_output_size,omitempty"`
+}
+
+// LemurSummaryRequest summarizes the transcripts with LeMUR.
+type LemurSummaryRequest struct {
+	TranscriptIDs []string `json:"transcript_ids"`
+	// Context describes the transcripts to improve the summary, e.g. "A customer support call", it is optional
+	Context string `json:"context,omitempty"`
+	// AnswerFormat describes the format of the summary, e.g. "TLDR" or "bullet points", it is optional
+	AnswerFormat  string `json:"answer_format,omitempty"`
+	FinalModel    string `json:"final_model,omitempty"`
+	MaxOutputSize int    `json:"max_output_size,omitempty"`
+}
+
+// LemurQuestion is a single question answered by LeMUR.
+type LemurQuestion struct {
+	Question string `json:"question"`
+	// Context describes the question or the transcripts to improve the answer, it is optional
+	Context string `json:"context,omitempty"`
+	// AnswerFormat describes the format of the answer, e.g. "short sentence", it can not be combined with AnswerOptions
+	AnswerFormat string `json:"answer_format,omitempty"`
+	// AnswerOptions are the valid answers, e.g. "Yes" and "No", it can not be combined with AnswerFormat
+	AnswerOptions []string `json:"answer_options,omitempty"`
+}
+
+// LemurQARequest answers the questions about the transcripts with LeMUR.
+type LemurQARequest struct {
+	TranscriptIDs []string        `json:"transcript_ids"`
+	Questions     []LemurQuestion `json:"questions"`
+	FinalModel    string          `json:"final_model,omitempty"`
+	MaxOutputSize int             `json:"max_output_size,omitempty"`
+}
+
+// LemurUsage is the number of tokens a LeMUR request consumed.
+type LemurUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+// LemurResponse is the generated response of a LeMUR task.
+type LemurResponse struct {
+	RequestId string     `json:"request_id"`
+	Response  string     `json:"response"`
+	Usage     LemurUsage `json:"usage"`
+}
+
+// LemurAnswer is the answer of LeMUR to a single question.
+type LemurAnswer struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
+
+// LemurQAResponse contains an answer for every question of a LemurQARequest in the order of the questions.
+type LemurQAResponse struct {
+	RequestId string        `json:"request_id"`
+	Response  []LemurAnswer `json:"response"`
+	Usage     LemurUsage    `json:"usage"`
+}
+
+// ByQuestion maps the text of each question to its answer.
+func (response *LemurQAResponse) ByQuestion() map[string]string {
+	answers := make(map[string]string, len(response.Response))
+	for _, answer := range response.Response {
+		answers[answer.Question] = answer.Answer
+	}
+	return answers
+}
+
+// validateTranscriptIDs checks that a LeMUR request refers to at least one transcript.
+func validateTranscriptIDs(ids []string) error {
+	if len(ids) == 0 {
+		return errors.New("lemur requires at least one transcript id")
+	}
+	for _, id := range ids {
+		if strings.TrimSpace(id) == "" {
+			return errors.New("lemur transcript ids must not be empty")
+		}
+	}
+	return nil
+}
+
+// Runs the prompt over the transcripts with LeMUR following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/lemur#run-a-task-using-lemur.
+// Returns the generated response and the consumed tokens
+func (client *AssemblyAImpl) LemurTask(req LemurTaskRequest) (*LemurResponse, error) {
+	if err := validateTranscriptIDs(req.TranscriptIDs); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Prompt) == "" {
+		return nil, errors.New("lemur task requires a prompt")
+	}
+	return postLemur[LemurResponse](client, "/generate/task", req)
+}
+
+// Summarizes the transcripts with LeMUR following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/lemur#summarize-a-transcript-using-lemur.
+// Returns the summary as the response text and the consumed tokens
+func (client *AssemblyAImpl) LemurSummary(req LemurSummaryRequest) (*LemurResponse, error) {
+	if err := validateTranscriptIDs(req.TranscriptIDs); err != nil {
+		return nil, err
+	}
+	return postLemur[LemurResponse](client, "/generate/summary", req)
+}
+
+// Answers the questions about the transcripts with LeMUR following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/lemur#ask-questions-using-lemur.
+// Returns an answer for every question and the consumed tokens
+func (client *AssemblyAImpl) LemurQuestionAnswer(req LemurQARequest) (*LemurQAResponse, error) {
+	if err := validateTranscriptIDs(req.TranscriptIDs); err != nil {
+		return nil, err
+	}
+	if len(req.Questions) == 0 {
+		return nil, errors.New("lemur question answer requires at least one question")
+	}
+	for i, question := range req.Questions {
+		if strings.TrimSpace(question.Question) == "" {
+			return nil, fmt.Errorf("lemur question %d is empty", i)
+		}
+		if question.AnswerFormat != "" && len(question.AnswerOptions) > 0 {
+			return nil, fmt.Errorf("lemur question %d can not combine answer_format and answer_options", i)
+		}
+	}
+	return postLemur[LemurQAResponse](client, "/generate/question-answer", req)
+}
+
+// lemurUrl returns the url of the LeMUR endpoint, LeMUR is versioned separately from the transcript api
+// so a trailing "/v2" of the base url is replaced by "/lemur/v3".
+func (client *AssemblyAImpl) lemurUrl(path string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(client.baseUrl, "/"), "/v2") + "/lemur/v3" + path
+}
+
+// postLemur sends the request to the LeMUR endpoint and decodes the response.
+func postLemur[T any](client *AssemblyAImpl, path string, request any) (*T, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := client.newRequest("POST", client.lemurUrl(path), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return getData[T](resp)
+}
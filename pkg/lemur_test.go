@@ -0,0 +1,211 @@
+package assemblyai
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLemurTask(t *testing.T) {
+	var path string
+	var body map[string]interface{}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		json.NewDecoder(req.Body).Decode(&body)
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"request_id": "5e1b27c2-691f-4414-8bc5-f14678442f9e",
+			"response": "The speaker is talking about the weather.",
+			"usage": {"input_tokens": 27, "output_tokens": 8}
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL+"/v2", "some-token", http.DefaultClient)
+
+	data, err := client.LemurTask(LemurTaskRequest{
+		TranscriptIDs: []string{"5551722-f677-48a6-9287-39c0aafd9ac1"},
+		Prompt:        "What is the speaker talking about?",
+		FinalModel:    "default",
+		MaxOutputSize: 2000,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &LemurResponse{
+		RequestId: "5e1b27c2-691f-4414-8bc5-f14678442f9e",
+		Response:  "The speaker is talking about the weather.",
+		Usage:     LemurUsage{InputTokens: 27, OutputTokens: 8},
+	}, data)
+	assert.Equal(t, "/lemur/v3/generate/task", path)
+	assert.Equal(t, map[string]interface{}{
+		"transcript_ids":  []interface{}{"5551722-f677-48a6-9287-39c0aafd9ac1"},
+		"prompt":          "What is the speaker talking about?",
+		"final_model":     "default",
+		"max_output_size": float64(2000),
+	}, body)
+}
+
+func TestLemurTaskOmitsDefaults(t *testing.T) {
+	var body map[string]interface{}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		json.NewDecoder(req.Body).Decode(&body)
+		res.WriteHeader(200)
+		res.Write([]byte(`{"request_id": "5e1b27c2-691f-4414-8bc5-f14678442f9e", "response": "Weather"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.LemurTask(LemurTaskRequest{TranscriptIDs: []string{"5551722-f677-48a6-9287-39c0aafd9ac1"}, Prompt: "Topic?"})
+	assert.NoError(t, err)
+	assert.NotContains(t, body, "final_model")
+	assert.NotContains(t, body, "max_output_size")
+}
+
+func TestLemurTaskInvalid(t *testing.T) {
+	client := New("http://localhost", "some-token", http.DefaultClient)
+	testCases := map[string]struct {
+		req LemurTaskRequest
+		err string
+	}{
+		"no transcripts": {req: LemurTaskRequest{Prompt: "Topic?"}, err: "lemur requires at least one transcript id"},
+		"empty id":       {req: LemurTaskRequest{TranscriptIDs: []string{" "}, Prompt: "Topic?"}, err: "lemur transcript ids must not be empty"},
+		"no prompt":      {req: LemurTaskRequest{TranscriptIDs: []string{"5551722-f677-48a6-9287-39c0aafd9ac1"}}, err: "lemur task requires a prompt"},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.LemurTask(testCase.req)
+			assert.EqualError(t, err, testCase.err)
+		})
+	}
+}
+
+func TestLemurTaskAPIError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(400)
+		res.Write([]byte(`{"error": "Transcript 5551722-f677-48a6-9287-39c0aafd9ac1 is not completed"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.LemurTask(LemurTaskRequest{TranscriptIDs: []string{"5551722-f677-48a6-9287-39c0aafd9ac1"}, Prompt: "Topic?"})
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, "Transcript 5551722-f677-48a6-9287-39c0aafd9ac1 is not completed", apiError.Message)
+}
+
+func TestLemurUrl(t *testing.T) {
+	testCases := map[string]string{
+		"https://api.assemblyai.com/v2":        "https://api.assemblyai.com/lemur/v3/generate/task",
+		"https://api.assemblyai.com/v2/":       "https://api.assemblyai.com/lemur/v3/generate/task",
+		"https://proxy.example.com/assemblyai": "https://proxy.example.com/assemblyai/lemur/v3/generate/task",
+	}
+	for baseUrl, expected := range testCases {
+		client := &AssemblyAImpl{baseUrl: baseUrl}
+		assert.Equal(t, expected, client.lemurUrl("/generate/task"))
+	}
+}
+
+func TestLemurSummary(t *testing.T) {
+	var path string
+	var body map[string]interface{}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		json.NewDecoder(req.Body).Decode(&body)
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"request_id": "5e1b27c2-691f-4414-8bc5-f14678442f9e",
+			"response": "- The customer asked about their invoice",
+			"usage": {"input_tokens": 120, "output_tokens": 9}
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.LemurSummary(LemurSummaryRequest{
+		TranscriptIDs: []string{"5551722-f677-48a6-9287-39c0aafd9ac1"},
+		Context:       "A customer support call",
+		AnswerFormat:  "bullet points",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "- The customer asked about their invoice", data.Response)
+	assert.Equal(t, LemurUsage{InputTokens: 120, OutputTokens: 9}, data.Usage)
+	assert.Equal(t, "/lemur/v3/generate/summary", path)
+	assert.Equal(t, map[string]interface{}{
+		"transcript_ids": []interface{}{"5551722-f677-48a6-9287-39c0aafd9ac1"},
+		"context":        "A customer support call",
+		"answer_format":  "bullet points",
+	}, body)
+}
+
+func TestLemurSummaryNoTranscripts(t *testing.T) {
+	client := New("http://localhost", "some-token", http.DefaultClient)
+
+	_, err := client.LemurSummary(LemurSummaryRequest{})
+	assert.EqualError(t, err, "lemur requires at least one transcript id")
+}
+
+func TestLemurQuestionAnswer(t *testing.T) {
+	var path string
+	var body map[string]interface{}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		json.NewDecoder(req.Body).Decode(&body)
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"request_id": "5e1b27c2-691f-4414-8bc5-f14678442f9e",
+			"response": [
+				{"question": "Was the customer satisfied?", "answer": "Yes"},
+				{"question": "What did the customer ask about?", "answer": "Their invoice"}
+			],
+			"usage": {"input_tokens": 140, "output_tokens": 5}
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.LemurQuestionAnswer(LemurQARequest{
+		TranscriptIDs: []string{"5551722-f677-48a6-9287-39c0aafd9ac1"},
+		Questions: []LemurQuestion{
+			{Question: "Was the customer satisfied?", AnswerOptions: []string{"Yes", "No"}},
+			{Question: "What did the customer ask about?", Context: "A customer support call", AnswerFormat: "short phrase"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/lemur/v3/generate/question-answer", path)
+	assert.Equal(t, []LemurAnswer{
+		{Question: "Was the customer satisfied?", Answer: "Yes"},
+		{Question: "What did the customer ask about?", Answer: "Their invoice"},
+	}, data.Response)
+	assert.Equal(t, map[string]string{
+		"Was the customer satisfied?":      "Yes",
+		"What did the customer ask about?": "Their invoice",
+	}, data.ByQuestion())
+	assert.Equal(t, LemurUsage{InputTokens: 140, OutputTokens: 5}, data.Usage)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"question": "Was the customer satisfied?", "answer_options": []interface{}{"Yes", "No"}},
+		map[string]interface{}{"question": "What did the customer ask about?", "context": "A customer support call", "answer_format": "short phrase"},
+	}, body["questions"])
+}
+
+func TestLemurQuestionAnswerInvalid(t *testing.T) {
+	client := New("http://localhost", "some-token", http.DefaultClient)
+	ids := []string{"5551722-f677-48a6-9287-39c0aafd9ac1"}
+	testCases := map[string]struct {
+		req LemurQARequest
+		err string
+	}{
+		"no transcripts": {req: LemurQARequest{Questions: []LemurQuestion{{Question: "Why?"}}}, err: "lemur requires at least one transcript id"},
+		"no questions":   {req: LemurQARequest{TranscriptIDs: ids}, err: "lemur question answer requires at least one question"},
+		"empty question": {req: LemurQARequest{TranscriptIDs: ids, Questions: []LemurQuestion{{Question: "Why?"}, {}}}, err: "lemur question 1 is empty"},
+		"format and options": {
+			req: LemurQARequest{TranscriptIDs: ids, Questions: []LemurQuestion{{Question: "Why?", AnswerFormat: "sentence", AnswerOptions: []string{"Yes"}}}},
+			err: "lemur question 0 can not combine answer_format and answer_options",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.LemurQuestionAnswer(testCase.req)
+			assert.EqualError(t, err, testCase.err)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package assemblyai
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// LogEvent describes a single request made by the client, it is passed to the logger set by WithLogger.
+type LogEvent struct {
+	Method string
+	URL    string
+	// StatusCode is the status code of the response, it is 0 if no response was received
+	StatusCode int
+	Duration   time.Duration
+	// Header contains the request headers, the authorization header is redacted
+	Header http.Header
+	// Err is the error of the request if no response was received
+	Err error
+}
+
+const redacted = "[REDACTED]"
+
+// loggingTransport calls logger with a LogEvent after every request of the base transport.
+type loggingTransport struct {
+	base   http.RoundTripper
+	logger func(event LogEvent)
+}
+
+func (transport *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := transport.base.RoundTrip(req)
+	token := req.Header.Get("authorization")
+	event := LogEvent{
+		Method:   req.Method,
+		URL:      redactToken(req.URL.String(), token),
+		Duration: time.Since(start),
+		Header:   redactHeader(req.Header),
+		Err:      redactError(err, token),
+	}
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	transport.logger(event)
+	return resp, err
+}
+
+// redactHeader returns a copy of header with the value of the authorization header replaced.
+func redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	if header.Get("authorization") != "" {
+		header.Set("authorization", redacted)
+	}
+	return header
+}
+
+// redactToken replaces every occurrence of the token in s.
+func redactToken(s string, token string) string {
+	if token == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, token, redacted)
+}
+
+// redactTokenBytes replaces every occurrence of the token in b.
+func redactTokenBytes(b []byte, token string) []byte {
+	if token == "" {
+		return b
+	}
+	return bytes.ReplaceAll(b, []byte(token), []byte(redacted))
+}
+
+// redactedError hides the token in the message of the wrapped error, errors.Is and errors.As still match the wrapped error.
+type redactedError struct {
+	err   error
+	token string
+}
+
+func (e *redactedError) Error() string {
+	return redactToken(e.err.Error(), e.token)
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactError wraps err in a redactedError if its message contains the token.
+func redactError(err error, token string) error {
+	if err == nil || token == "" || !strings.Contains(err.Error(), token) {
+		return err
+	}
+	return &redactedError{err: err, token: token}
+}
@@ -0,0 +1,80 @@
+package assemblyai
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const secretToken = "c0ffee-secret-token"
+
+func TestRedactTokenAPIError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(400)
+		res.Write([]byte(`{"error": "invalid authorization header ` + req.Header.Get("authorization") + `"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, secretToken, http.DefaultClient)
+
+	_, err := client.GetTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.NotContains(t, err.Error(), secretToken)
+	assert.Equal(t, "invalid authorization header [REDACTED]", apiError.Message)
+	assert.NotContains(t, string(apiError.Body), secretToken)
+}
+
+func TestRedactTokenDecodeError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`authorization: ` + req.Header.Get("authorization")))
+	})
+	defer server.Close()
+	client := New(server.URL, secretToken, http.DefaultClient)
+
+	_, err := client.GetTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.ErrorContains(t, err, "authorization: [REDACTED]")
+	assert.NotContains(t, err.Error(), secretToken)
+}
+
+func TestRedactTokenRequestError(t *testing.T) {
+	errConnection := errors.New("connection refused for token " + secretToken)
+	httpClient := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errConnection
+	})}
+	client := New("http://localhost", secretToken, httpClient)
+
+	_, err := client.Transcript("https://some-url.com/some-id")
+	assert.ErrorIs(t, err, errConnection)
+	assert.ErrorContains(t, err, "connection refused for token [REDACTED]")
+	assert.NotContains(t, err.Error(), secretToken)
+}
+
+func TestRedactTokenLogger(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"transcripts": [], "page_details": {}}`))
+	})
+	defer server.Close()
+	events := []LogEvent{}
+	client := NewClient(secretToken, WithBaseURL(server.URL+"/"+secretToken), WithLogger(func(event LogEvent) {
+		events = append(events, event)
+	}))
+
+	_, err := client.ListTranscripts(ListParams{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, server.URL+"/[REDACTED]/transcript", events[0].URL)
+	assert.Equal(t, "[REDACTED]", events[0].Header.Get("authorization"))
+}
+
+func TestRedactError(t *testing.T) {
+	err := errors.New("request with " + secretToken + " failed")
+	assert.EqualError(t, redactError(err, secretToken), "request with [REDACTED] failed")
+	assert.ErrorIs(t, redactError(err, secretToken), err)
+	assert.Equal(t, err, redactError(err, "other-token"))
+	assert.Equal(t, err, redactError(err, ""))
+	assert.Nil(t, redactError(nil, secretToken))
+}
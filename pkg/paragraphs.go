@@ -0,0 +1,36 @@
+package assemblyai
+
+import "fmt"
+
+// Paragraph is a segment of the transcript split by AssemblyAI, Start and End are in milliseconds.
+type Paragraph struct {
+	Text       string  `json:"text"`
+	Start      int     `json:"start"`
+	End        int     `json:"end"`
+	Confidence float64 `json:"confidence"`
+	Words      []Word  `json:"words"`
+}
+
+type paragraphsResponse struct {
+	Paragraphs []Paragraph `json:"paragraphs"`
+}
+
+// Fetches the paragraphs of a completed transcription job following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/transcript#get-paragraphs-in-transcript.
+// Returns the paragraphs including their timestamps and words, or ErrTranscriptNotCompleted if the job is not completed yet
+func (client *AssemblyAImpl) GetParagraphs(id string) ([]Paragraph, error) {
+	url := fmt.Sprintf("%s/transcript/%s/paragraphs", client.baseUrl, id)
+	req, err := client.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := getData[paragraphsResponse](resp)
+	if err != nil {
+		return nil, client.notCompletedError(id, err)
+	}
+	return data.Paragraphs, nil
+}
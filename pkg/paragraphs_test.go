@@ -0,0 +1,104 @@
+package assemblyai
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetParagraphs(t *testing.T) {
+	content, err := os.ReadFile("testdata/transcript_paragraphs.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var path, authorization string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		authorization = req.Header.Get("authorization")
+		res.WriteHeader(200)
+		res.Write(content)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	paragraphs, err := client.GetParagraphs("a7d3e1f9-2c4b-4e8a-b6d0-9f1c3e5a7b22")
+	assert.NoError(t, err)
+	assert.Equal(t, "/transcript/a7d3e1f9-2c4b-4e8a-b6d0-9f1c3e5a7b22/paragraphs", path)
+	assert.Equal(t, "some-token", authorization)
+	assert.Len(t, paragraphs, 2)
+	assert.Equal(t, "Thanks for listening.", paragraphs[1].Text)
+	assert.Equal(t, 10830, paragraphs[1].Start)
+	assert.Equal(t, 12090, paragraphs[1].End)
+	assert.Equal(t, 0.9133, paragraphs[1].Confidence)
+	assert.Equal(t, Word{Text: "listening.", Start: 11420, End: 12090, Confidence: 0.89}, paragraphs[1].Words[2])
+
+	for _, paragraph := range paragraphs {
+		texts := []string{}
+		for _, word := range paragraph.Words {
+			texts = append(texts, word.Text)
+		}
+		assert.Equal(t, paragraph.Text, strings.Join(texts, " "))
+		assert.Equal(t, paragraph.Start, paragraph.Words[0].Start)
+		assert.Equal(t, paragraph.End, paragraph.Words[len(paragraph.Words)-1].End)
+	}
+}
+
+func TestGetParagraphsNotFound(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(404)
+		res.Write([]byte(`{"error": "Transcript not found"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	paragraphs, err := client.GetParagraphs("a7d3e1f9-2c4b-4e8a-b6d0-9f1c3e5a7b22")
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 404, apiError.StatusCode)
+	assert.Nil(t, paragraphs)
+}
+
+func TestGetParagraphsNotCompleted(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		if req.URL.Path == "/transcript/a7d3e1f9-2c4b-4e8a-b6d0-9f1c3e5a7b22/paragraphs" {
+			res.WriteHeader(400)
+			res.Write([]byte(`{"error": "Transcript is not completed yet"}`))
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "a7d3e1f9-2c4b-4e8a-b6d0-9f1c3e5a7b22", "status": "processing"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	paragraphs, err := client.GetParagraphs("a7d3e1f9-2c4b-4e8a-b6d0-9f1c3e5a7b22")
+	assert.ErrorIs(t, err, ErrTranscriptNotCompleted)
+	assert.EqualError(t, err, "transcript is not completed, status is processing")
+	assert.Nil(t, paragraphs)
+	assert.Equal(t, 2, requests)
+}
+
+func TestGetParagraphsBadRequest(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/transcript/a7d3e1f9-2c4b-4e8a-b6d0-9f1c3e5a7b22/paragraphs" {
+			res.WriteHeader(400)
+			res.Write([]byte(`{"error": "Invalid transcript id"}`))
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "a7d3e1f9-2c4b-4e8a-b6d0-9f1c3e5a7b22", "status": "completed"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.GetParagraphs("a7d3e1f9-2c4b-4e8a-b6d0-9f1c3e5a7b22")
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, "Invalid transcript id", apiError.Message)
+	assert.NotErrorIs(t, err, ErrTranscriptNotCompleted)
+}
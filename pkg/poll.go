@@ -0,0 +1,325 @@
+package assemblyai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+type PollSettings struct {
+	// Frequency defines the poll frequency, a zero value defaults to 5 seconds
+	Frequency time.Duration
+	// Timeout defines the maximum polling time, a zero value defaults to 1 minute unless MaxAttempts is set
+	Timeout time.Duration
+	// MaxAttempts limits the number of polls, a zero value does not limit them. If Timeout is set as well, the first limit reached ends polling
+	MaxAttempts int
+	// Backoff multiplies the poll interval by Multiplier after every poll, starting at Frequency
+	Backoff bool
+	// Multiplier is the factor the poll interval grows by if Backoff is enabled, a zero value defaults to 2
+	Multiplier float64
+	// MaxInterval caps the poll interval if Backoff or AdaptiveFraction is enabled, a zero value does not cap the interval.
+	// A wait never extends past the Timeout
+	MaxInterval time.Duration
+	// AdaptiveFraction between 0 and 1 sets the poll interval to the given fraction of the expected remaining processing time,
+	// once a poll returns the audio duration. The interval is at least Frequency and at most MaxInterval, a zero value disables it
+	AdaptiveFraction float64
+	// MaxRetries is the number of consecutive 429, 502 and 503 responses which are retried before polling fails,
+	// a zero value defaults to 3 and a negative value fails on the first one. The Retry-After header of the response is honored
+	// unless it exceeds the Timeout, which ends polling with ErrPollTimeout right away.
+	// Without the header the wait starts at Frequency and doubles with every consecutive retry up to MaxInterval
+	MaxRetries int
+	// Jitter between 0 and 1 randomizes each wait by up to the given fraction of the interval, e.g. 0.1 waits 9 to 11 seconds for a 10 second interval
+	Jitter float64
+	// OnStatus is called with the status of the job after every poll, it is optional
+	OnStatus func(status TranscriptionStatus)
+	// OnPoll is called with the number of the poll starting at 1, the response and the wait until the next poll after every successful poll,
+	// it is optional. It can not abort polling and is called with the terminal response and a zero wait last
+	OnPoll func(attempt int, resp *TranscriptResponse, next time.Duration)
+	// sleep waits between two polls, it is replaced in tests to record the intervals without waiting
+	sleep func(ctx context.Context, d time.Duration) error
+	// now returns the current time to measure the Timeout, it is replaced in tests together with sleep
+	now func() time.Time
+}
+
+const (
+	defaultPollFrequency = time.Second * 5
+	defaultPollTimeout   = time.Minute
+	defaultPollRetries   = 3
+	// expectedProcessingRatio is the processing time of a job relative to the duration of its audio AssemblyAI takes roughly
+	expectedProcessingRatio = 0.3
+)
+
+// NewPollSettings returns settings polling every frequency until timeout.
+// A zero frequency defaults to 5 seconds and a zero timeout to 1 minute
+func NewPollSettings(frequency time.Duration, timeout time.Duration) *PollSettings {
+	return (&PollSettings{Frequency: frequency, Timeout: timeout}).withDefaults()
+}
+
+// withDefaults returns a copy of the settings where zero values are replaced by the defaults.
+func (pollSettings *PollSettings) withDefaults() *PollSettings {
+	settings := PollSettings{}
+	if pollSettings != nil {
+		settings = *pollSettings
+	}
+	if settings.Frequency <= 0 {
+		settings.Frequency = defaultPollFrequency
+	}
+	if settings.Timeout <= 0 && settings.MaxAttempts <= 0 {
+		settings.Timeout = defaultPollTimeout
+	}
+	if settings.MaxRetries == 0 {
+		settings.MaxRetries = defaultPollRetries
+	}
+	if settings.sleep == nil {
+		settings.sleep = sleepContext
+	}
+	if settings.now == nil {
+		settings.now = time.Now
+	}
+	return &settings
+}
+
+// nextInterval returns the interval to wait after the current one.
+func (pollSettings *PollSettings) nextInterval(interval time.Duration) time.Duration {
+	if !pollSettings.Backoff {
+		return pollSettings.Frequency
+	}
+	multiplier := pollSettings.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	interval = time.Duration(float64(interval) * multiplier)
+	if pollSettings.MaxInterval > 0 && interval > pollSettings.MaxInterval {
+		interval = pollSettings.MaxInterval
+	}
+	return interval
+}
+
+// retryWait returns the wait before retrying the poll after the given number of consecutive retryable errors.
+func (pollSettings *PollSettings) retryWait(apiError *APIError, retries int) time.Duration {
+	if apiError.RetryAfter > 0 {
+		return apiError.RetryAfter
+	}
+	wait := pollSettings.Frequency
+	for i := 1; i < retries && (pollSettings.MaxInterval <= 0 || wait < pollSettings.MaxInterval); i++ {
+		wait *= 2
+	}
+	if pollSettings.MaxInterval > 0 && wait > pollSettings.MaxInterval {
+		wait = pollSettings.MaxInterval
+	}
+	return wait
+}
+
+// isRetryablePollError reports whether polling continues after the error, which is the case for rate limited and unavailable responses.
+func isRetryablePollError(err error) (*APIError, bool) {
+	var apiError *APIError
+	if !errors.As(err, &apiError) {
+		return nil, false
+	}
+	switch apiError.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return apiError, true
+	}
+	return nil, false
+}
+
+// adaptiveInterval returns AdaptiveFraction of the expected remaining processing time of audio with the given duration,
+// elapsed is the time the job has been polled for. The interval is bounded by Frequency and MaxInterval.
+func (pollSettings *PollSettings) adaptiveInterval(audioDuration time.Duration, elapsed time.Duration) time.Duration {
+	remaining := time.Duration(float64(audioDuration)*expectedProcessingRatio) - elapsed
+	interval := time.Duration(float64(remaining) * pollSettings.AdaptiveFraction)
+	if interval < pollSettings.Frequency {
+		interval = pollSettings.Frequency
+	}
+	if pollSettings.MaxInterval > 0 && interval > pollSettings.MaxInterval {
+		interval = pollSettings.MaxInterval
+	}
+	return interval
+}
+
+// withJitter randomizes the interval by up to Jitter times the interval in both directions.
+func (pollSettings *PollSettings) withJitter(interval time.Duration) time.Duration {
+	jitter := pollSettings.Jitter
+	if jitter <= 0 {
+		return interval
+	}
+	if jitter > 1 {
+		jitter = 1
+	}
+	return time.Duration(float64(interval) * (1 + jitter*(2*rand.Float64()-1)))
+}
+
+// ErrPollTimeout is returned if the transcription job is not finished within the Timeout of the PollSettings.
+var ErrPollTimeout = errors.New("transcription polling timed out")
+
+// ErrMaxAttemptsExceeded is returned if the transcription job is not finished within the MaxAttempts of the PollSettings.
+var ErrMaxAttemptsExceeded = errors.New("transcription polling exceeded the maximum attempts")
+
+type TranscriptionStatus string
+
+const (
+	Err        TranscriptionStatus = "error"
+	Queued     TranscriptionStatus = "queued"
+	Processing TranscriptionStatus = "processing"
+	Completed  TranscriptionStatus = "completed"
+	// Throttled jobs are queued until the concurrency limit of the account allows processing them
+	Throttled TranscriptionStatus = "throttled"
+)
+
+// Polls the transcription job based on a id.
+// Optionally you can provide pollSettings to define the poll frequency and timeout
+// pollSettings.Frequency defines the poll frequency and defaults to 5 seconds
+// pollSettings.Timeout defines the maximum polling time and defaults to 1 minute
+// pollSettings.Backoff multiplies the poll interval by pollSettings.Multiplier after every poll up to pollSettings.MaxInterval
+// pollSettings.Jitter randomizes every wait by a fraction of the interval
+// pollSettings.AdaptiveFraction adjusts the poll interval to the expected remaining processing time once the audio duration is known
+// pollSettings.MaxAttempts limits the number of polls
+// pollSettings.MaxRetries limits the number of consecutive 429, 502 and 503 responses which are retried
+// returns the transcribed text if the status is completed, an error matching ErrTranscriptFailed if the status is error,
+// ErrPollTimeout if the job is not finished within pollSettings.Timeout or ErrMaxAttemptsExceeded if it is not finished within pollSettings.MaxAttempts
+func (client *AssemblyAImpl) PollTranscript(id string, pollSettings *PollSettings) (string, error) {
+	data, err := client.PollTranscriptFull(id, pollSettings)
+	if err != nil {
+		return "", err
+	}
+	return data.Text, nil
+}
+
+// Polls the transcription job based on a id like PollTranscript, but stops as soon as ctx is done.
+// A deadline of ctx earlier than pollSettings.Timeout ends polling at the deadline
+// returns the transcribed text if the status is completed or ctx.Err() if ctx is done first
+func (client *AssemblyAImpl) PollTranscriptContext(ctx context.Context, id string, pollSettings *PollSettings) (string, error) {
+	data, err := client.pollTranscriptFull(ctx, id, pollSettings)
+	if err != nil {
+		return "", err
+	}
+	return data.Text, nil
+}
+
+// Polls the transcription job based on a id like PollTranscript.
+// returns the full transcript response if the status is completed
+func (client *AssemblyAImpl) PollTranscriptFull(id string, pollSettings *PollSettings) (*TranscriptResponse, error) {
+	return client.pollTranscriptFull(context.Background(), id, pollSettings)
+}
+
+// TranscriptResult is the outcome of PollTranscriptAsync, either Transcript or Err is set.
+type TranscriptResult struct {
+	Transcript *TranscriptResponse
+	Err        error
+}
+
+// Polls the transcription job based on a id like PollTranscriptContext in a separate goroutine.
+// The goroutine stops once the job is done, polling fails or ctx is done, the receiver does not have to read the channel
+// returns a channel receiving a single result before it is closed
+func (client *AssemblyAImpl) PollTranscriptAsync(ctx context.Context, id string, pollSettings *PollSettings) (<-chan TranscriptResult, error) {
+	if id == "" {
+		return nil, errors.New("id must not be empty")
+	}
+	results := make(chan TranscriptResult, 1)
+	go func() {
+		defer close(results)
+		data, err := client.pollTranscriptFull(ctx, id, pollSettings)
+		results <- TranscriptResult{Transcript: data, Err: err}
+	}()
+	return results, nil
+}
+
+func (client *AssemblyAImpl) pollTranscriptFull(ctx context.Context, id string, pollSettings *PollSettings) (*TranscriptResponse, error) {
+	if pollSettings == nil {
+		pollSettings = client.defaultPollSettings
+	}
+	pollSettings = pollSettings.withDefaults()
+	interval := pollSettings.Frequency
+	start := pollSettings.now()
+	timeoutTime := start.Add(pollSettings.Timeout)
+	var lastStatus TranscriptionStatus
+	retries := 0
+	for attempt := 1; ; {
+		if pollSettings.Timeout > 0 && !pollSettings.now().Before(timeoutTime) {
+			return nil, fmt.Errorf("%w after %s%s", ErrPollTimeout, pollSettings.Timeout, lastStatusMessage(lastStatus))
+		}
+		data, err := client.getTranscript(ctx, id)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			apiError, retryable := isRetryablePollError(err)
+			if !retryable || retries >= pollSettings.MaxRetries {
+				return nil, err
+			}
+			// a retried request does not count as an attempt
+			retries++
+			wait := pollSettings.retryWait(apiError, retries)
+			if remaining := timeoutTime.Sub(pollSettings.now()); pollSettings.Timeout > 0 && wait > remaining {
+				// waiting for the requested Retry-After is pointless if the job can not be polled again before the timeout
+				if apiError.RetryAfter > 0 {
+					return nil, fmt.Errorf("%w after %s%s", ErrPollTimeout, pollSettings.Timeout, lastStatusMessage(lastStatus))
+				}
+				wait = remaining
+			}
+			if err := pollSettings.sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		retries = 0
+		status := data.Status
+		// queued, processing, throttled and unknown statuses keep polling until a limit is reached
+		lastAttempt := pollSettings.MaxAttempts > 0 && attempt >= pollSettings.MaxAttempts
+		var wait time.Duration
+		if status != Err && status != Completed && !lastAttempt {
+			if pollSettings.AdaptiveFraction > 0 && data.AudioDuration != nil {
+				interval = pollSettings.adaptiveInterval(time.Duration(*data.AudioDuration*float64(time.Second)), pollSettings.now().Sub(start))
+			}
+			wait = pollSettings.withJitter(interval)
+			// the last wait ends at the timeout instead of overshooting it
+			if remaining := timeoutTime.Sub(pollSettings.now()); pollSettings.Timeout > 0 && wait > remaining {
+				wait = remaining
+			}
+		}
+		if pollSettings.OnStatus != nil {
+			pollSettings.OnStatus(status)
+		}
+		if pollSettings.OnPoll != nil {
+			pollSettings.OnPoll(attempt, data, wait)
+		}
+		lastStatus = data.Status
+		switch status {
+		case Err:
+			return nil, data.err()
+		case Completed:
+			return data, nil
+		}
+		if lastAttempt {
+			return nil, fmt.Errorf("%w of %d%s", ErrMaxAttemptsExceeded, pollSettings.MaxAttempts, lastStatusMessage(lastStatus))
+		}
+		if err := pollSettings.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		interval = pollSettings.nextInterval(interval)
+		attempt++
+	}
+}
+
+// lastStatusMessage describes the last status of the job for the error ending polling, it is empty if the job was not polled.
+func lastStatusMessage(status TranscriptionStatus) string {
+	if status == "" {
+		return ""
+	}
+	return ", last status " + string(status)
+}
+
+// sleepContext waits for the duration d or until ctx is done, in which case it returns ctx.Err().
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
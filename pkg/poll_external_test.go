@@ -0,0 +1,62 @@
+package assemblyai_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assemblyai "github.com/DooomiT/assembly-ai-go/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func getQueuedServer(requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		*requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+	}))
+}
+
+func TestNewPollSettingsDefaults(t *testing.T) {
+	settings := assemblyai.NewPollSettings(0, 0)
+	assert.Equal(t, time.Second*5, settings.Frequency)
+	assert.Equal(t, time.Minute, settings.Timeout)
+
+	settings = assemblyai.NewPollSettings(time.Second, time.Minute*10)
+	assert.Equal(t, time.Second, settings.Frequency)
+	assert.Equal(t, time.Minute*10, settings.Timeout)
+}
+
+func TestNewPollSettingsFrequency(t *testing.T) {
+	fastRequests, slowRequests := 0, 0
+	fast := getQueuedServer(&fastRequests)
+	defer fast.Close()
+	slow := getQueuedServer(&slowRequests)
+	defer slow.Close()
+
+	_, err := assemblyai.New(fast.URL, "some-token", http.DefaultClient).
+		PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", assemblyai.NewPollSettings(time.Millisecond*10, time.Millisecond*100))
+	assert.Error(t, err)
+	_, err = assemblyai.New(slow.URL, "some-token", http.DefaultClient).
+		PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", assemblyai.NewPollSettings(time.Millisecond*60, time.Millisecond*100))
+	assert.Error(t, err)
+
+	assert.GreaterOrEqual(t, fastRequests, 5)
+	assert.Equal(t, 2, slowRequests)
+}
+
+func TestPollSettingsTimeout(t *testing.T) {
+	requests := 0
+	server := getQueuedServer(&requests)
+	defer server.Close()
+	client := assemblyai.New(server.URL, "some-token", http.DefaultClient)
+
+	start := time.Now()
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &assemblyai.PollSettings{
+		Frequency: time.Millisecond * 10,
+		Timeout:   time.Millisecond * 50,
+	})
+	assert.ErrorIs(t, err, assemblyai.ErrPollTimeout)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,880 @@
+package assemblyai
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollSettingsNextInterval(t *testing.T) {
+	settings := (&PollSettings{Frequency: time.Second}).withDefaults()
+	assert.Equal(t, time.Second, settings.nextInterval(time.Second))
+	assert.Equal(t, time.Second, settings.nextInterval(time.Second*4))
+
+	settings = (&PollSettings{Frequency: time.Second, Backoff: true, MaxInterval: time.Second * 10}).withDefaults()
+	interval := settings.Frequency
+	intervals := []time.Duration{}
+	for i := 0; i < 6; i++ {
+		intervals = append(intervals, interval)
+		interval = settings.nextInterval(interval)
+	}
+	assert.Equal(t, []time.Duration{
+		time.Second, time.Second * 2, time.Second * 4, time.Second * 8, time.Second * 10, time.Second * 10,
+	}, intervals)
+
+	settings = (&PollSettings{Frequency: time.Second, Backoff: true}).withDefaults()
+	assert.Equal(t, time.Second*64, settings.nextInterval(time.Second*32))
+}
+
+func TestPollSettingsAdaptiveInterval(t *testing.T) {
+	testCases := []struct {
+		name          string
+		settings      PollSettings
+		audioDuration time.Duration
+		elapsed       time.Duration
+		expected      time.Duration
+	}{
+		{"two hours", PollSettings{Frequency: time.Second * 5, AdaptiveFraction: 0.5}, time.Hour * 2, 0, time.Minute * 18},
+		{"two hours after ten minutes", PollSettings{Frequency: time.Second * 5, AdaptiveFraction: 0.5}, time.Hour * 2, time.Minute * 10, time.Minute * 13},
+		{"two hours capped", PollSettings{Frequency: time.Second * 5, AdaptiveFraction: 0.5, MaxInterval: time.Minute * 5}, time.Hour * 2, 0, time.Minute * 5},
+		{"short audio", PollSettings{Frequency: time.Second * 5, AdaptiveFraction: 0.5}, time.Second * 20, 0, time.Second * 5},
+		{"longer than expected", PollSettings{Frequency: time.Second * 5, AdaptiveFraction: 0.5}, time.Minute * 10, time.Hour, time.Second * 5},
+		{"small fraction", PollSettings{Frequency: time.Second, AdaptiveFraction: 0.1}, time.Hour, 0, time.Second * 108},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.settings.adaptiveInterval(testCase.audioDuration, testCase.elapsed))
+		})
+	}
+}
+
+// pollWithRecordedSleeps polls a fake server answering queued for the given number of polls before completing,
+// the waits between the polls are recorded instead of slept.
+func pollWithRecordedSleeps(t *testing.T, queuedPolls int, settings *PollSettings) []time.Duration {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		status := "queued"
+		if requests == queuedPolls {
+			status = "completed"
+		}
+		requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + status + `"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	sleeps := []time.Duration{}
+	settings.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", settings)
+	assert.NoError(t, err)
+	return sleeps
+}
+
+func TestPollTranscriptIntervals(t *testing.T) {
+	testCases := []struct {
+		name     string
+		settings *PollSettings
+		expected []time.Duration
+	}{
+		{
+			"fixed frequency by default",
+			&PollSettings{},
+			[]time.Duration{time.Second * 5, time.Second * 5, time.Second * 5, time.Second * 5, time.Second * 5, time.Second * 5},
+		},
+		{
+			"doubling up to the max interval",
+			&PollSettings{Frequency: time.Second * 2, Backoff: true, MaxInterval: time.Second * 30},
+			[]time.Duration{time.Second * 2, time.Second * 4, time.Second * 8, time.Second * 16, time.Second * 30, time.Second * 30},
+		},
+		{
+			"custom multiplier",
+			&PollSettings{Frequency: time.Second, Backoff: true, Multiplier: 1.5, MaxInterval: time.Second * 4},
+			[]time.Duration{time.Second, time.Millisecond * 1500, time.Millisecond * 2250, time.Millisecond * 3375, time.Second * 4, time.Second * 4},
+		},
+		{
+			"multiplier without backoff",
+			&PollSettings{Frequency: time.Second, Multiplier: 3},
+			[]time.Duration{time.Second, time.Second, time.Second, time.Second, time.Second, time.Second},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, pollWithRecordedSleeps(t, 6, testCase.settings))
+		})
+	}
+}
+
+func TestPollTranscriptJitter(t *testing.T) {
+	sleeps := pollWithRecordedSleeps(t, 50, &PollSettings{Frequency: time.Second * 10, Jitter: 0.2})
+	assert.Len(t, sleeps, 50)
+	distinct := map[time.Duration]bool{}
+	for _, sleep := range sleeps {
+		assert.GreaterOrEqual(t, sleep, time.Second*8)
+		assert.LessOrEqual(t, sleep, time.Second*12)
+		distinct[sleep] = true
+	}
+	assert.Greater(t, len(distinct), 1)
+}
+
+func TestPollTranscriptJitterWithBackoff(t *testing.T) {
+	sleeps := pollWithRecordedSleeps(t, 4, &PollSettings{Frequency: time.Second, Backoff: true, Jitter: 0.1})
+	for i, base := range []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 8} {
+		assert.InDelta(t, float64(base), float64(sleeps[i]), float64(base)/10)
+	}
+}
+
+func TestPollTranscriptBackoff(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "queued"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	// polls at 0ms, 10ms, 30ms, 70ms and 150ms before the timeout is reached
+	clock := &fakeClock{}
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", clock.install(&PollSettings{
+		Frequency: time.Millisecond * 10,
+		Timeout:   time.Millisecond * 230,
+		Backoff:   true,
+	}))
+	assert.ErrorIs(t, err, ErrPollTimeout)
+	assert.Equal(t, 5, requests)
+}
+
+func TestPollTranscriptOnStatus(t *testing.T) {
+	statuses := []string{"queued", "queued", "processing", "completed"}
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		status := statuses[requests]
+		requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + status + `", "text": "Hello"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	observed := []TranscriptionStatus{}
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		Frequency: time.Millisecond,
+		OnStatus: func(status TranscriptionStatus) {
+			observed = append(observed, status)
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+	assert.Equal(t, []TranscriptionStatus{"queued", "queued", "processing", "completed"}, observed)
+}
+
+func TestPollTranscriptOnStatusError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "error", "error": "Download error"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	observed := []TranscriptionStatus{}
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		OnStatus: func(status TranscriptionStatus) {
+			observed = append(observed, status)
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, []TranscriptionStatus{Err}, observed)
+}
+
+func TestPollTranscriptOnPoll(t *testing.T) {
+	statuses := []TranscriptionStatus{Queued, Processing, Completed}
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		status := statuses[requests]
+		requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + string(status) + `", "text": "Hello"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	attempts := []int{}
+	observed := []TranscriptionStatus{}
+	waits := []time.Duration{}
+	data, err := client.PollTranscriptFull("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		Frequency: time.Millisecond,
+		OnPoll: func(attempt int, resp *TranscriptResponse, next time.Duration) {
+			attempts = append(attempts, attempt)
+			observed = append(observed, resp.Status)
+			waits = append(waits, next)
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", data.Text)
+	assert.Equal(t, []int{1, 2, 3}, attempts)
+	assert.Equal(t, statuses, observed)
+	assert.Equal(t, []time.Duration{time.Millisecond, time.Millisecond, 0}, waits)
+}
+
+func TestPollTranscriptOnPollNotCalledOnRequestError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(500)
+		res.Write([]byte(`{"error": "Internal server error"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	called := false
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		OnPoll: func(attempt int, resp *TranscriptResponse, next time.Duration) {
+			called = true
+		},
+	})
+	assert.Error(t, err)
+	assert.False(t, called)
+}
+
+// getProcessingServer returns a fake server always answering processing and counting the polls.
+// fakeClock is a clock for the PollSettings which only advances by the recorded sleeps.
+type fakeClock struct {
+	current time.Time
+	sleeps  []time.Duration
+}
+
+// install replaces the clock and sleep of the settings by the fake clock.
+func (clock *fakeClock) install(settings *PollSettings) *PollSettings {
+	clock.current = time.Date(2023, 5, 24, 8, 0, 0, 0, time.UTC)
+	settings.now = func() time.Time {
+		return clock.current
+	}
+	settings.sleep = func(ctx context.Context, d time.Duration) error {
+		clock.sleeps = append(clock.sleeps, d)
+		clock.current = clock.current.Add(d)
+		return nil
+	}
+	return settings
+}
+
+func getProcessingServer(requests *int32) *httptest.Server {
+	return getServer(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(requests, 1)
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "processing"}`))
+	})
+}
+
+func TestPollTranscriptMaxAttempts(t *testing.T) {
+	for _, maxAttempts := range []int{1, 3, 20} {
+		t.Run(fmt.Sprint(maxAttempts), func(t *testing.T) {
+			var requests int32
+			server := getProcessingServer(&requests)
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			sleeps := 0
+			settings := &PollSettings{MaxAttempts: maxAttempts}
+			settings.sleep = func(ctx context.Context, d time.Duration) error {
+				sleeps++
+				return nil
+			}
+			_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", settings)
+			assert.ErrorIs(t, err, ErrMaxAttemptsExceeded)
+			assert.NotErrorIs(t, err, ErrPollTimeout)
+			assert.EqualError(t, err, fmt.Sprintf("transcription polling exceeded the maximum attempts of %d, last status processing", maxAttempts))
+			assert.Equal(t, int32(maxAttempts), atomic.LoadInt32(&requests))
+			assert.Equal(t, maxAttempts-1, sleeps)
+		})
+	}
+}
+
+func TestPollTranscriptMaxAttemptsCompleted(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		status := "processing"
+		if requests == 3 {
+			status = "completed"
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + status + `", "text": "Hello"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{Frequency: time.Millisecond, MaxAttempts: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+}
+
+func TestPollTranscriptTimeoutBeforeMaxAttempts(t *testing.T) {
+	var requests int32
+	server := getProcessingServer(&requests)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	clock := &fakeClock{}
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", clock.install(&PollSettings{
+		Frequency:   time.Millisecond * 20,
+		Timeout:     time.Millisecond * 50,
+		MaxAttempts: 100,
+	}))
+	assert.ErrorIs(t, err, ErrPollTimeout)
+	assert.NotErrorIs(t, err, ErrMaxAttemptsExceeded)
+	assert.EqualError(t, err, "transcription polling timed out after 50ms, last status processing")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestPollTranscriptMaxAttemptsBeforeTimeout(t *testing.T) {
+	var requests int32
+	server := getProcessingServer(&requests)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		Frequency:   time.Millisecond,
+		Timeout:     time.Minute,
+		MaxAttempts: 5,
+	})
+	assert.ErrorIs(t, err, ErrMaxAttemptsExceeded)
+	assert.Equal(t, int32(5), atomic.LoadInt32(&requests))
+}
+
+func TestPollTranscriptProcessing(t *testing.T) {
+	for _, status := range []string{"processing", "some-new-status"} {
+		t.Run(status, func(t *testing.T) {
+			requests := 0
+			server := getServer(func(res http.ResponseWriter, req *http.Request) {
+				requests++
+				res.WriteHeader(200)
+				res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + status + `"}`))
+			})
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			// polls at 0ms, 20ms and 40ms before the timeout is reached
+			clock := &fakeClock{}
+			_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", clock.install(&PollSettings{
+				Frequency: time.Millisecond * 20,
+				Timeout:   time.Millisecond * 50,
+			}))
+			assert.ErrorIs(t, err, ErrPollTimeout)
+			assert.Equal(t, 3, requests)
+		})
+	}
+}
+
+func TestPollTranscriptProcessingRespectsFrequency(t *testing.T) {
+	statuses := []string{"queued", "processing", "processing", "processing", "completed"}
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		status := statuses[requests]
+		requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + status + `", "text": "Hello"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	start := time.Now()
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		Frequency: time.Millisecond * 20,
+		Timeout:   time.Second,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+	assert.Equal(t, len(statuses), requests)
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*20*time.Duration(len(statuses)-1))
+}
+
+func TestPollTranscriptReusesConnections(t *testing.T) {
+	requests := 0
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		status := "processing"
+		if requests == 50 {
+			status = "completed"
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + status + `", "text": "Hello"}`))
+	}))
+	var connections int32
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+	transport := &http.Transport{}
+	defer transport.CloseIdleConnections()
+	client := New(server.URL, "some-token", &http.Client{Transport: transport})
+
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{Frequency: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+	assert.Equal(t, 50, requests)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&connections))
+}
+
+func TestPollTranscriptContextCancel(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "processing"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(time.Millisecond*50, cancel)
+	start := time.Now()
+	text, err := client.PollTranscriptContext(ctx, "5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		Frequency: time.Second * 10,
+		Timeout:   time.Minute,
+	})
+	elapsed := time.Since(start)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "", text)
+	assert.Equal(t, 1, requests)
+	assert.Less(t, elapsed, time.Millisecond*100)
+}
+
+func TestPollTranscriptContextDeadline(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*30)
+	defer cancel()
+	start := time.Now()
+	_, err := client.PollTranscriptContext(ctx, "5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		Frequency: time.Millisecond * 10,
+		Timeout:   time.Minute,
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Millisecond*500)
+}
+
+func TestPollTranscriptContextCompleted(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed", "text": "Hello"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	text, err := client.PollTranscriptContext(context.Background(), "5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+}
+
+func TestPollTranscriptContextCancelledBeforeRequest(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.PollTranscriptContext(ctx, "5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, requests)
+}
+
+// assertNoGoroutineLeak waits up to a second for the number of goroutines to drop to the given number,
+// assert.Eventually is not used because it runs the condition in a goroutine of its own.
+func assertNoGoroutineLeak(t *testing.T, goroutines int, transport *http.Transport) {
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > goroutines && time.Now().Before(deadline) {
+		transport.CloseIdleConnections()
+		time.Sleep(time.Millisecond * 10)
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), goroutines)
+}
+
+// pollAsync polls the fake server with PollTranscriptAsync and checks that no goroutine of the poll remains once the result was received.
+func pollAsync(t *testing.T, ctx context.Context, handler http.HandlerFunc) TranscriptResult {
+	goroutines := runtime.NumGoroutine()
+	server := getServer(handler)
+	transport := &http.Transport{}
+	client := New(server.URL, "some-token", &http.Client{Transport: transport})
+
+	results, err := client.PollTranscriptAsync(ctx, "5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{Frequency: time.Millisecond})
+	assert.NoError(t, err)
+	var result TranscriptResult
+	select {
+	case result = <-results:
+	case <-time.After(time.Second):
+		t.Fatal("no result received")
+	}
+	_, open := <-results
+	assert.False(t, open)
+
+	transport.CloseIdleConnections()
+	server.Close()
+	assertNoGoroutineLeak(t, goroutines, transport)
+	return result
+}
+
+func TestPollTranscriptAsync(t *testing.T) {
+	statuses := []string{"queued", "processing", "completed"}
+	requests := 0
+	result := pollAsync(t, context.Background(), func(res http.ResponseWriter, req *http.Request) {
+		status := statuses[requests]
+		requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + status + `", "text": "Hello"}`))
+	})
+	assert.NoError(t, result.Err)
+	assert.Equal(t, "Hello", result.Transcript.Text)
+	assert.Equal(t, 3, requests)
+}
+
+func TestPollTranscriptAsyncAPIError(t *testing.T) {
+	result := pollAsync(t, context.Background(), func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(404)
+		res.Write([]byte(`{"error": "Transcript not found"}`))
+	})
+	var apiError *APIError
+	assert.ErrorAs(t, result.Err, &apiError)
+	assert.Equal(t, 404, apiError.StatusCode)
+	assert.Nil(t, result.Transcript)
+}
+
+func TestPollTranscriptAsyncCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := pollAsync(t, ctx, func(res http.ResponseWriter, req *http.Request) {
+		cancel()
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "processing"}`))
+	})
+	assert.ErrorIs(t, result.Err, context.Canceled)
+	assert.Nil(t, result.Transcript)
+}
+
+func TestPollTranscriptAsyncUnreadResult(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	requested := make(chan bool, 1)
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed"}`))
+		requested <- true
+	})
+	transport := &http.Transport{}
+	client := New(server.URL, "some-token", &http.Client{Transport: transport})
+
+	_, err := client.PollTranscriptAsync(context.Background(), "5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.NoError(t, err)
+	<-requested
+	server.Close()
+	assertNoGoroutineLeak(t, goroutines, transport)
+}
+
+func TestPollTranscriptAsyncEmptyId(t *testing.T) {
+	client := New("http://localhost", "some-token", http.DefaultClient)
+
+	results, err := client.PollTranscriptAsync(context.Background(), "", nil)
+	assert.Error(t, err)
+	assert.Nil(t, results)
+}
+
+func TestPollTranscriptAdaptive(t *testing.T) {
+	testCases := []struct {
+		name          string
+		audioDuration string
+		expected      []time.Duration
+	}{
+		{"with audio duration", "7200", []time.Duration{time.Minute * 10, time.Minute * 10, 0}},
+		{"without audio duration", "null", []time.Duration{time.Second * 5, time.Second * 5, 0}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			statuses := []string{"queued", "processing", "completed"}
+			requests := 0
+			server := getServer(func(res http.ResponseWriter, req *http.Request) {
+				status := statuses[requests]
+				requests++
+				res.WriteHeader(200)
+				res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + status + `", "audio_duration": ` + testCase.audioDuration + `}`))
+			})
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			waits := []time.Duration{}
+			sleeps := []time.Duration{}
+			settings := &PollSettings{
+				AdaptiveFraction: 0.5,
+				MaxInterval:      time.Minute * 10,
+				Timeout:          time.Hour,
+				OnPoll: func(attempt int, resp *TranscriptResponse, next time.Duration) {
+					waits = append(waits, next)
+				},
+			}
+			settings.sleep = func(ctx context.Context, d time.Duration) error {
+				sleeps = append(sleeps, d)
+				return nil
+			}
+			_, err := client.PollTranscriptFull("5551722-f677-48a6-9287-39c0aafd9ac1", settings)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, waits)
+			assert.Equal(t, testCase.expected[:2], sleeps)
+		})
+	}
+}
+
+func TestPollTranscriptWaitLimitedByTimeout(t *testing.T) {
+	testCases := []struct {
+		name     string
+		settings PollSettings
+		expected []time.Duration
+	}{
+		{"adaptive", PollSettings{Frequency: time.Second * 5, AdaptiveFraction: 0.5}, []time.Duration{time.Minute}},
+		{"backoff", PollSettings{Frequency: time.Second * 10, Backoff: true, Multiplier: 3}, []time.Duration{time.Second * 10, time.Second * 30, time.Second * 20}},
+		{"frequency", PollSettings{Frequency: time.Second * 25}, []time.Duration{time.Second * 25, time.Second * 25, time.Second * 10}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := getServer(func(res http.ResponseWriter, req *http.Request) {
+				res.WriteHeader(200)
+				res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "processing", "audio_duration": 7200}`))
+			})
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			clock := &fakeClock{}
+			settings := testCase.settings
+			waits := []time.Duration{}
+			settings.OnPoll = func(attempt int, resp *TranscriptResponse, next time.Duration) {
+				waits = append(waits, next)
+			}
+			_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", clock.install(&settings))
+			assert.ErrorIs(t, err, ErrPollTimeout)
+			assert.Equal(t, testCase.expected, clock.sleeps)
+			assert.Equal(t, testCase.expected, waits)
+			elapsed := time.Duration(0)
+			for _, sleep := range clock.sleeps {
+				assert.LessOrEqual(t, sleep, time.Minute-elapsed)
+				elapsed += sleep
+			}
+			assert.Equal(t, time.Minute, elapsed)
+		})
+	}
+}
+
+// getFlakyServer returns a server responding with the given status codes and headers before the job is completed.
+func getFlakyServer(requests *int, statusCodes []int, header http.Header) *httptest.Server {
+	return getServer(func(res http.ResponseWriter, req *http.Request) {
+		*requests++
+		if *requests <= len(statusCodes) {
+			for key, values := range header {
+				res.Header()[key] = values
+			}
+			res.WriteHeader(statusCodes[*requests-1])
+			res.Write([]byte(`{"error": "Too many requests"}`))
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed", "text": "Hello"}`))
+	})
+}
+
+func TestPollTranscriptRetryAfter(t *testing.T) {
+	requests := 0
+	server := getFlakyServer(&requests, []int{429}, http.Header{"Retry-After": {"7"}})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	sleeps := []time.Duration{}
+	attempts := []int{}
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		Frequency: time.Second,
+		OnPoll: func(attempt int, resp *TranscriptResponse, next time.Duration) {
+			attempts = append(attempts, attempt)
+		},
+		sleep: func(ctx context.Context, d time.Duration) error {
+			sleeps = append(sleeps, d)
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, []time.Duration{time.Second * 7}, sleeps)
+	assert.Equal(t, []int{1}, attempts)
+}
+
+func TestPollTranscriptRetryAfterWaits(t *testing.T) {
+	requests := 0
+	server := getFlakyServer(&requests, []int{429}, http.Header{"Retry-After": {"1"}})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	start := time.Now()
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{Frequency: time.Millisecond, Timeout: time.Second * 5})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestPollTranscriptRetryAfterExceedsTimeout(t *testing.T) {
+	statusCodes := []int{200, 429}
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		if statusCodes[requests-1] == 429 {
+			res.Header().Set("Retry-After", "3600")
+			res.WriteHeader(429)
+			res.Write([]byte(`{"error": "Too many requests"}`))
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "processing"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	clock := &fakeClock{}
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", clock.install(&PollSettings{
+		Frequency: time.Second,
+		Timeout:   time.Minute,
+	}))
+	assert.ErrorIs(t, err, ErrPollTimeout)
+	assert.EqualError(t, err, "transcription polling timed out after 1m0s, last status processing")
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, []time.Duration{time.Second}, clock.sleeps)
+}
+
+func TestPollTranscriptRetryBackoffLimitedByTimeout(t *testing.T) {
+	requests := 0
+	server := getFlakyServer(&requests, []int{503, 503, 503}, nil)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	clock := &fakeClock{}
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", clock.install(&PollSettings{
+		Frequency: time.Second * 20,
+		Timeout:   time.Minute,
+	}))
+	assert.ErrorIs(t, err, ErrPollTimeout)
+	assert.Equal(t, []time.Duration{time.Second * 20, time.Second * 40}, clock.sleeps)
+	assert.Equal(t, 2, requests)
+}
+
+func TestPollTranscriptRetryBackoff(t *testing.T) {
+	requests := 0
+	server := getFlakyServer(&requests, []int{503, 502, 429}, nil)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	sleeps := []time.Duration{}
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+		Frequency:   time.Second,
+		MaxInterval: time.Second * 3,
+		sleep: func(ctx context.Context, d time.Duration) error {
+			sleeps = append(sleeps, d)
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, requests)
+	assert.Equal(t, []time.Duration{time.Second, time.Second * 2, time.Second * 3}, sleeps)
+}
+
+func TestPollTranscriptRetriesExceeded(t *testing.T) {
+	testCases := map[string]struct {
+		maxRetries int
+		requests   int
+	}{
+		"default":  {maxRetries: 0, requests: 4},
+		"custom":   {maxRetries: 1, requests: 2},
+		"disabled": {maxRetries: -1, requests: 1},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			server := getFlakyServer(&requests, []int{429, 429, 429, 429, 429}, nil)
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+				MaxRetries: testCase.maxRetries,
+				sleep: func(ctx context.Context, d time.Duration) error {
+					return nil
+				},
+			})
+			var apiError *APIError
+			assert.ErrorAs(t, err, &apiError)
+			assert.Equal(t, 429, apiError.StatusCode)
+			assert.Equal(t, testCase.requests, requests)
+		})
+	}
+}
+
+func TestPollTranscriptNotRetried(t *testing.T) {
+	for _, statusCode := range []int{400, 401, 404, 500} {
+		t.Run(strconv.Itoa(statusCode), func(t *testing.T) {
+			requests := 0
+			server := getFlakyServer(&requests, []int{statusCode}, nil)
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{
+				sleep: func(ctx context.Context, d time.Duration) error {
+					return nil
+				},
+			})
+			var apiError *APIError
+			assert.ErrorAs(t, err, &apiError)
+			assert.Equal(t, statusCode, apiError.StatusCode)
+			assert.Equal(t, 1, requests)
+		})
+	}
+}
+
+func TestPollTranscriptUnknownStatus(t *testing.T) {
+	for _, status := range []string{string(Throttled), "transcoding"} {
+		t.Run(status, func(t *testing.T) {
+			requests := 0
+			server := getServer(func(res http.ResponseWriter, req *http.Request) {
+				requests++
+				res.WriteHeader(200)
+				res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "` + status + `"}`))
+			})
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			clock := &fakeClock{}
+			_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", clock.install(&PollSettings{
+				Frequency: time.Millisecond * 20,
+				Timeout:   time.Millisecond * 50,
+			}))
+			assert.ErrorIs(t, err, ErrPollTimeout)
+			assert.EqualError(t, err, "transcription polling timed out after 50ms, last status "+status)
+			assert.Equal(t, 3, requests)
+			assert.Equal(t, []time.Duration{time.Millisecond * 20, time.Millisecond * 20, time.Millisecond * 10}, clock.sleeps)
+		})
+	}
+}
@@ -0,0 +1,460 @@
+package assemblyai
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	defaultRealtimeUrl        = "wss://api.assemblyai.com/v2/realtime/ws"
+	defaultRealtimeSampleRate = 16000
+	// realtimeCloseTimeout limits the time Close waits for AssemblyAI to terminate the session
+	realtimeCloseTimeout = time.Second * 5
+	// realtimeWriteTimeout limits the time a close frame is written for
+	realtimeWriteTimeout = time.Second
+	// minRealtimeTokenExpiry and maxRealtimeTokenExpiry are the bounds of expires_in allowed by AssemblyAI
+	minRealtimeTokenExpiry = time.Minute
+	maxRealtimeTokenExpiry = time.Second * 360000
+)
+
+// RealtimePartial is a transcript of the audio sent to a realtime session.
+// A partial transcript is updated by the following ones until a final transcript replaces it
+type RealtimePartial struct {
+	// MessageType is either PartialTranscript or FinalTranscript
+	MessageType string `json:"message_type"`
+	// AudioStart and AudioEnd are the time range of the transcribed audio in milliseconds since the start of the session
+	AudioStart int     `json:"audio_start"`
+	AudioEnd   int     `json:"audio_end"`
+	Confidence float64 `json:"confidence"`
+	Text       string  `json:"text"`
+	Words      []Word  `json:"words"`
+	Created    string  `json:"created"`
+}
+
+// Final reports whether the transcript is final and will not be updated anymore.
+func (partial RealtimePartial) Final() bool {
+	return partial.MessageType == "FinalTranscript"
+}
+
+// RealtimeError is returned if AssemblyAI closes a realtime session with an error, e.g. the code 4001 for an invalid token.
+type RealtimeError struct {
+	Code   int
+	Reason string
+}
+
+func (e *RealtimeError) Error() string {
+	return fmt.Sprintf("assemblyai closed the realtime session with code %d: %s", e.Code, e.Reason)
+}
+
+// ErrRealtimeNotConnected is returned if audio is sent before Connect succeeded or after the session is closed.
+var ErrRealtimeNotConnected = errors.New("realtime session is not connected")
+
+type realtimeTokenDto struct {
+	ExpiresIn int `json:"expires_in"`
+}
+
+type RealtimeTokenResponse struct {
+	Token string `json:"token"`
+}
+
+// Creates a temporary token for the realtime transcription following the AssemblyAI documentation https://www.assemblyai.com/docs/guides/real-time-streaming-transcription#creating-temporary-authentication-tokens.
+// The temporary token lets a browser connect with WithTemporaryToken without exposing the api token.
+// expiresIn is rounded down to whole seconds and must be between 1 minute and 100 hours.
+// Returns the temporary token
+func (client *AssemblyAImpl) CreateRealtimeToken(expiresIn time.Duration) (string, error) {
+	if expiresIn < minRealtimeTokenExpiry || expiresIn > maxRealtimeTokenExpiry {
+		return "", fmt.Errorf("expires_in must be between %s and %s, got %s", minRealtimeTokenExpiry, maxRealtimeTokenExpiry, expiresIn)
+	}
+	body, err := json.Marshal(realtimeTokenDto{ExpiresIn: int(expiresIn / time.Second)})
+	if err != nil {
+		return "", err
+	}
+	req, err := client.newRequest("POST", client.baseUrl+"/realtime/token", bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	data, err := getData[RealtimeTokenResponse](resp)
+	if err != nil {
+		return "", err
+	}
+	if data.Token == "" {
+		return "", errors.New("response did not include a token")
+	}
+	return data.Token, nil
+}
+
+// realtimeMessage is a message AssemblyAI sends during a realtime session.
+type realtimeMessage struct {
+	MessageType string `json:"message_type"`
+	SessionId   string `json:"session_id"`
+	Error       string `json:"error"`
+}
+
+// RealtimeClient streams audio to the realtime transcription of AssemblyAI https://www.assemblyai.com/docs/guides/real-time-streaming-transcription.
+// SendAudio and Close are safe for concurrent use.
+type RealtimeClient struct {
+	url            string
+	token          string
+	temporaryToken bool
+	sampleRate     int
+	tlsConfig      *tls.Config
+	userAgent      string
+
+	mu sync.Mutex
+	// connecting is set by the first Connect, a client can not be connected again even if it failed
+	connecting bool
+	conn       *websocket.Conn
+	// writeMu serializes the writes to conn, which supports only a single concurrent writer
+	writeMu    sync.Mutex
+	sessionId  string
+	terminated bool
+	err        error
+	results    chan RealtimePartial
+	// stop is closed to end the session without waiting for AssemblyAI, done is closed once the session ended
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// RealtimeOption configures a RealtimeClient created by NewRealtimeClient.
+type RealtimeOption func(client *RealtimeClient)
+
+// WithRealtimeURL sets the websocket url of the realtime transcription, by default "wss://api.assemblyai.com/v2/realtime/ws".
+func WithRealtimeURL(realtimeUrl string) RealtimeOption {
+	return func(client *RealtimeClient) {
+		client.url = realtimeUrl
+	}
+}
+
+// WithSampleRate sets the sample rate of the audio in Hz, by default 16000.
+func WithSampleRate(sampleRate int) RealtimeOption {
+	return func(client *RealtimeClient) {
+		client.sampleRate = sampleRate
+	}
+}
+
+// WithTemporaryToken marks the token as a temporary token created by CreateRealtimeToken,
+// it is sent as query parameter instead of the authorization header.
+func WithTemporaryToken() RealtimeOption {
+	return func(client *RealtimeClient) {
+		client.temporaryToken = true
+	}
+}
+
+// WithRealtimeTLSConfig sets the tls config used to connect to a wss url.
+func WithRealtimeTLSConfig(config *tls.Config) RealtimeOption {
+	return func(client *RealtimeClient) {
+		client.tlsConfig = config
+	}
+}
+
+// Creates a new realtime client.
+// token is your AssemblyAI api token or a temporary token together with WithTemporaryToken.
+// Returns a client which is connected by Connect
+func NewRealtimeClient(token string, opts ...RealtimeOption) *RealtimeClient {
+	client := &RealtimeClient{
+		url:        defaultRealtimeUrl,
+		token:      token,
+		sampleRate: defaultRealtimeSampleRate,
+		userAgent:  DefaultUserAgent,
+		results:    make(chan RealtimePartial, 64),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
+}
+
+// Results returns the channel receiving the partial and final transcripts, it is closed once the session ended
+// or Connect failed.
+func (client *RealtimeClient) Results() <-chan RealtimePartial {
+	return client.results
+}
+
+// SessionId returns the id of the session AssemblyAI assigned on Connect.
+func (client *RealtimeClient) SessionId() string {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.sessionId
+}
+
+// Err returns the error which ended the session, it is nil if the session was terminated by Close.
+// It must only be called after Results is closed
+func (client *RealtimeClient) Err() error {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.err
+}
+
+// Connects to the realtime transcription and waits until AssemblyAI began the session.
+// ctx bounds dialing, the handshake and waiting for the session to begin, the session itself is ended by Close.
+// A client can only be connected once, if connecting fails Results is closed and Err returns the error.
+// Returns an APIError if the connection was rejected or a RealtimeError if AssemblyAI closed the session right away
+func (client *RealtimeClient) Connect(ctx context.Context) error {
+	client.mu.Lock()
+	if client.connecting {
+		client.mu.Unlock()
+		return errors.New("realtime client is already connected")
+	}
+	client.connecting = true
+	client.mu.Unlock()
+
+	conn, err := client.dial(ctx)
+	if err != nil {
+		client.end(err)
+		return err
+	}
+	sessionId, err := client.begin(ctx, conn)
+	if err != nil {
+		conn.Close()
+		client.end(err)
+		return err
+	}
+	client.mu.Lock()
+	client.conn = conn
+	client.sessionId = sessionId
+	client.mu.Unlock()
+
+	go client.read(conn)
+	return nil
+}
+
+// Sends the pcm16 audio with the sample rate of the client to the session.
+// Returns ErrRealtimeNotConnected if the session is not connected
+func (client *RealtimeClient) SendAudio(pcm []byte) error {
+	client.mu.Lock()
+	conn := client.conn
+	closed := client.terminated || client.err != nil
+	client.mu.Unlock()
+	if conn == nil || closed {
+		return ErrRealtimeNotConnected
+	}
+	message, err := json.Marshal(map[string]string{"audio_data": base64.StdEncoding.EncodeToString(pcm)})
+	if err != nil {
+		return err
+	}
+	return client.write(conn, websocket.TextMessage, message)
+}
+
+// Terminates the session and waits until AssemblyAI sent the remaining final transcripts and closed the connection.
+// Results must be read concurrently, otherwise the session is closed without waiting after 5 seconds.
+// Returns the error which ended the session if it ended before
+func (client *RealtimeClient) Close() error {
+	client.mu.Lock()
+	conn := client.conn
+	alreadyTerminated := client.terminated
+	client.terminated = true
+	client.mu.Unlock()
+	if conn == nil {
+		return ErrRealtimeNotConnected
+	}
+	if !alreadyTerminated {
+		if err := client.write(conn, websocket.TextMessage, []byte(`{"terminate_session": true}`)); err != nil {
+			client.abort(err)
+		}
+	}
+	timer := time.NewTimer(realtimeCloseTimeout)
+	defer timer.Stop()
+	select {
+	case <-client.done:
+	case <-timer.C:
+		client.abort(errors.New("realtime session was not terminated in time"))
+		<-client.done
+	}
+	return client.Err()
+}
+
+// dial opens the connection and performs the websocket handshake.
+func (client *RealtimeClient) dial(ctx context.Context) (*websocket.Conn, error) {
+	realtimeUrl, err := url.Parse(client.url)
+	if err != nil {
+		return nil, err
+	}
+	if realtimeUrl.Scheme != "ws" && realtimeUrl.Scheme != "wss" {
+		return nil, fmt.Errorf("unsupported realtime url scheme %q", realtimeUrl.Scheme)
+	}
+	query := realtimeUrl.Query()
+	query.Set("sample_rate", strconv.Itoa(client.sampleRate))
+	if client.temporaryToken {
+		query.Set("token", client.token)
+	}
+	realtimeUrl.RawQuery = query.Encode()
+
+	header := http.Header{}
+	header.Set("User-Agent", client.userAgent)
+	if !client.temporaryToken {
+		header.Set("authorization", client.token)
+	}
+	dialer := websocket.Dialer{Proxy: http.ProxyFromEnvironment, TLSClientConfig: client.tlsConfig}
+	conn, resp, err := dialer.DialContext(ctx, realtimeUrl.String(), header)
+	if err != nil {
+		if errors.Is(err, websocket.ErrBadHandshake) && resp != nil && resp.StatusCode != http.StatusSwitchingProtocols {
+			defer resp.Body.Close()
+			body, readErr := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+			if readErr != nil {
+				return nil, readErr
+			}
+			return nil, newAPIError(resp.StatusCode, redactTokenBytes(body, client.token))
+		}
+		return nil, redactError(err, client.token)
+	}
+	return conn, nil
+}
+
+// end closes Results after Connect failed.
+func (client *RealtimeClient) end(err error) {
+	client.fail(err)
+	close(client.results)
+	close(client.done)
+}
+
+// begin waits for the SessionBegins message and returns the id of the session.
+// ctx interrupts the wait by expiring the read deadline, which is cleared again once the session began.
+func (client *RealtimeClient) begin(ctx context.Context, conn *websocket.Conn) (string, error) {
+	begun := make(chan struct{})
+	watched := make(chan struct{})
+	go func() {
+		defer close(watched)
+		select {
+		case <-ctx.Done():
+			conn.SetReadDeadline(time.Now())
+		case <-begun:
+		}
+	}()
+	sessionId, err := client.awaitSessionBegins(ctx, conn)
+	close(begun)
+	<-watched
+	if err != nil {
+		return "", err
+	}
+	// ctx may have been canceled after the session began, the deadline set by the watcher must not end the session
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return "", err
+	}
+	return sessionId, nil
+}
+
+// awaitSessionBegins reads the messages until the SessionBegins message arrives.
+func (client *RealtimeClient) awaitSessionBegins(ctx context.Context, conn *websocket.Conn) (string, error) {
+	for {
+		_, payload, err := conn.ReadMessage()
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return "", ctxErr
+			}
+			var closeError *websocket.CloseError
+			if errors.As(err, &closeError) {
+				return "", &RealtimeError{Code: closeError.Code, Reason: closeError.Text}
+			}
+			return "", err
+		}
+		var message realtimeMessage
+		if err := json.Unmarshal(payload, &message); err != nil {
+			return "", fmt.Errorf("could not decode realtime message: %w", err)
+		}
+		if message.Error != "" {
+			return "", errors.New(message.Error)
+		}
+		if message.MessageType == "SessionBegins" {
+			return message.SessionId, nil
+		}
+	}
+}
+
+// read receives the messages of the session until the connection is closed.
+func (client *RealtimeClient) read(conn *websocket.Conn) {
+	defer close(client.done)
+	defer close(client.results)
+	defer conn.Close()
+	for {
+		_, payload, err := conn.ReadMessage()
+		if err != nil {
+			var closeError *websocket.CloseError
+			if !errors.As(err, &closeError) {
+				client.fail(err)
+			} else if closeError.Code != websocket.CloseNormalClosure && closeError.Code != websocket.CloseNoStatusReceived {
+				client.fail(&RealtimeError{Code: closeError.Code, Reason: closeError.Text})
+			}
+			return
+		}
+		var message realtimeMessage
+		if err := json.Unmarshal(payload, &message); err != nil {
+			client.fail(fmt.Errorf("could not decode realtime message: %w", err))
+			return
+		}
+		switch {
+		case message.Error != "":
+			client.fail(errors.New(message.Error))
+			return
+		case message.MessageType == "PartialTranscript" || message.MessageType == "FinalTranscript":
+			var partial RealtimePartial
+			if err := json.Unmarshal(payload, &partial); err != nil {
+				client.fail(fmt.Errorf("could not decode realtime transcript: %w", err))
+				return
+			}
+			select {
+			case client.results <- partial:
+			case <-client.stop:
+				return
+			}
+		case message.MessageType == "SessionTerminated":
+			client.mu.Lock()
+			client.terminated = true
+			client.mu.Unlock()
+			client.write(conn, websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			return
+		}
+	}
+}
+
+// write sends a single message, conn supports only one concurrent writer.
+func (client *RealtimeClient) write(conn *websocket.Conn, messageType int, data []byte) error {
+	client.writeMu.Lock()
+	defer client.writeMu.Unlock()
+	if messageType == websocket.CloseMessage {
+		return conn.WriteControl(messageType, data, time.Now().Add(realtimeWriteTimeout))
+	}
+	return conn.WriteMessage(messageType, data)
+}
+
+// abort ends the session with the error without waiting for AssemblyAI.
+func (client *RealtimeClient) abort(err error) {
+	client.fail(err)
+	client.stopOnce.Do(func() {
+		close(client.stop)
+		client.mu.Lock()
+		conn := client.conn
+		client.mu.Unlock()
+		conn.Close()
+	})
+}
+
+// fail records the first error ending the session.
+func (client *RealtimeClient) fail(err error) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.err == nil {
+		client.err = err
+	}
+}
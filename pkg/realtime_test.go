@@ -0,0 +1,344 @@
+package assemblyai
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+const sessionBegins = `{"message_type": "SessionBegins", "session_id": "5551722-f677-48a6-9287-39c0aafd9ac1", "expires_at": "2023-05-24T08:09:10.161850"}`
+
+// getRealtimeServer returns a server accepting the websocket handshake and handing the connection to the session function.
+func getRealtimeServer(t *testing.T, session func(conn *websocket.Conn, req *http.Request)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(res, req, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		session(conn, req)
+	}))
+}
+
+// closeSession sends a close frame with the given code and reason to the client.
+func closeSession(conn *websocket.Conn, code int, reason string) {
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason))
+}
+
+// realtimeURL returns the websocket url of the test server.
+func realtimeURL(server *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+// serveSession begins the session, answers the audio with a partial and final transcript and terminates on request.
+func serveSession(conn *websocket.Conn, req *http.Request) {
+	conn.WriteMessage(websocket.TextMessage, []byte(sessionBegins))
+	for {
+		_, payload, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var message map[string]interface{}
+		json.Unmarshal(payload, &message)
+		if message["terminate_session"] == true {
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"message_type": "SessionTerminated"}`))
+			conn.ReadMessage()
+			return
+		}
+		audio, _ := base64.StdEncoding.DecodeString(message["audio_data"].(string))
+		conn.WriteMessage(websocket.TextMessage, []byte(`{"message_type": "PartialTranscript", "audio_start": 0, "audio_end": 1500, "text": "`+string(audio)+`"}`))
+		conn.WriteMessage(websocket.TextMessage, []byte(`{"message_type": "FinalTranscript", "audio_start": 0, "audio_end": 1500, "confidence": 0.98, "text": "`+string(audio)+`.", "words": [{"start": 0, "end": 1500, "confidence": 0.98, "text": "`+string(audio)+`."}]}`))
+	}
+}
+
+// collect reads the results until the channel is closed.
+func collect(results <-chan RealtimePartial) chan []RealtimePartial {
+	collected := make(chan []RealtimePartial, 1)
+	go func() {
+		partials := []RealtimePartial{}
+		for partial := range results {
+			partials = append(partials, partial)
+		}
+		collected <- partials
+	}()
+	return collected
+}
+
+func TestRealtimeClient(t *testing.T) {
+	server := getRealtimeServer(t, func(conn *websocket.Conn, req *http.Request) {
+		assert.Equal(t, "some-token", req.Header.Get("authorization"))
+		assert.Equal(t, "8000", req.URL.Query().Get("sample_rate"))
+		assert.False(t, req.URL.Query().Has("token"))
+		serveSession(conn, req)
+	})
+	defer server.Close()
+	client := NewRealtimeClient("some-token", WithRealtimeURL(realtimeURL(server)), WithSampleRate(8000))
+
+	assert.NoError(t, client.Connect(context.Background()))
+	assert.Equal(t, "5551722-f677-48a6-9287-39c0aafd9ac1", client.SessionId())
+	collected := collect(client.Results())
+	assert.NoError(t, client.SendAudio([]byte("Hello")))
+	assert.NoError(t, client.Close())
+
+	partials := <-collected
+	assert.Len(t, partials, 2)
+	assert.False(t, partials[0].Final())
+	assert.Equal(t, "Hello", partials[0].Text)
+	assert.True(t, partials[1].Final())
+	assert.Equal(t, "Hello.", partials[1].Text)
+	assert.Equal(t, 1500, partials[1].AudioEnd)
+	assert.Equal(t, 0.98, partials[1].Confidence)
+	assert.Len(t, partials[1].Words, 1)
+	assert.NoError(t, client.Err())
+	assert.ErrorIs(t, client.SendAudio([]byte("Hello")), ErrRealtimeNotConnected)
+}
+
+func TestRealtimeClientTemporaryToken(t *testing.T) {
+	server := getRealtimeServer(t, func(conn *websocket.Conn, req *http.Request) {
+		assert.Equal(t, "", req.Header.Get("authorization"))
+		assert.Equal(t, "temporary-token", req.URL.Query().Get("token"))
+		assert.Equal(t, "16000", req.URL.Query().Get("sample_rate"))
+		serveSession(conn, req)
+	})
+	defer server.Close()
+	client := NewRealtimeClient("temporary-token", WithRealtimeURL(realtimeURL(server)), WithTemporaryToken())
+
+	assert.NoError(t, client.Connect(context.Background()))
+	collected := collect(client.Results())
+	assert.NoError(t, client.Close())
+	assert.Empty(t, <-collected)
+}
+
+func TestRealtimeClientRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusUnauthorized)
+		res.Write([]byte(`{"error": "Authentication error, API token missing/invalid"}`))
+	}))
+	defer server.Close()
+	client := NewRealtimeClient("invalid-token", WithRealtimeURL(realtimeURL(server)))
+
+	err := client.Connect(context.Background())
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, http.StatusUnauthorized, apiError.StatusCode)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	assert.Empty(t, <-collect(client.Results()))
+	assert.Equal(t, err, client.Err())
+	assert.ErrorIs(t, client.SendAudio([]byte("Hello")), ErrRealtimeNotConnected)
+	assert.ErrorIs(t, client.Close(), ErrRealtimeNotConnected)
+	assert.EqualError(t, client.Connect(context.Background()), "realtime client is already connected")
+}
+
+func TestRealtimeClientClosedOnBegin(t *testing.T) {
+	server := getRealtimeServer(t, func(conn *websocket.Conn, req *http.Request) {
+		closeSession(conn, 4001, "Not Authorized")
+	})
+	defer server.Close()
+	client := NewRealtimeClient("some-token", WithRealtimeURL(realtimeURL(server)))
+
+	err := client.Connect(context.Background())
+	var realtimeError *RealtimeError
+	assert.ErrorAs(t, err, &realtimeError)
+	assert.Equal(t, 4001, realtimeError.Code)
+	assert.Equal(t, "Not Authorized", realtimeError.Reason)
+	assert.EqualError(t, err, "assemblyai closed the realtime session with code 4001: Not Authorized")
+	assert.Empty(t, <-collect(client.Results()))
+	assert.ErrorAs(t, client.Err(), &realtimeError)
+}
+
+func TestRealtimeClientClosedDuringSession(t *testing.T) {
+	server := getRealtimeServer(t, func(conn *websocket.Conn, req *http.Request) {
+		conn.WriteMessage(websocket.TextMessage, []byte(sessionBegins))
+		conn.ReadMessage()
+		closeSession(conn, 4031, "Client sent audio too fast")
+		conn.ReadMessage()
+	})
+	defer server.Close()
+	client := NewRealtimeClient("some-token", WithRealtimeURL(realtimeURL(server)))
+
+	assert.NoError(t, client.Connect(context.Background()))
+	collected := collect(client.Results())
+	assert.NoError(t, client.SendAudio([]byte("Hello")))
+	assert.Empty(t, <-collected)
+	var realtimeError *RealtimeError
+	assert.ErrorAs(t, client.Err(), &realtimeError)
+	assert.Equal(t, 4031, realtimeError.Code)
+	assert.ErrorIs(t, client.SendAudio([]byte("Hello")), ErrRealtimeNotConnected)
+	assert.ErrorAs(t, client.Close(), &realtimeError)
+}
+
+func TestRealtimeClientErrorMessage(t *testing.T) {
+	server := getRealtimeServer(t, func(conn *websocket.Conn, req *http.Request) {
+		conn.WriteMessage(websocket.TextMessage, []byte(sessionBegins))
+		conn.WriteMessage(websocket.TextMessage, []byte(`{"error": "Audio duration is too long"}`))
+		conn.ReadMessage()
+	})
+	defer server.Close()
+	client := NewRealtimeClient("some-token", WithRealtimeURL(realtimeURL(server)))
+
+	assert.NoError(t, client.Connect(context.Background()))
+	assert.Empty(t, <-collect(client.Results()))
+	assert.EqualError(t, client.Err(), "Audio duration is too long")
+}
+
+func TestRealtimeClientContextOnlyBoundsConnect(t *testing.T) {
+	server := getRealtimeServer(t, serveSession)
+	defer server.Close()
+	client := NewRealtimeClient("some-token", WithRealtimeURL(realtimeURL(server)))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	assert.NoError(t, client.Connect(ctx))
+	collected := collect(client.Results())
+	<-ctx.Done()
+	time.Sleep(time.Millisecond * 20)
+	assert.NoError(t, client.SendAudio([]byte("Hello")))
+	assert.NoError(t, client.Close())
+	assert.Len(t, <-collected, 2)
+	assert.NoError(t, client.Err())
+}
+
+func TestRealtimeClientContextCanceledAfterConnect(t *testing.T) {
+	server := getRealtimeServer(t, serveSession)
+	defer server.Close()
+
+	for i := 0; i < 50; i++ {
+		client := NewRealtimeClient("some-token", WithRealtimeURL(realtimeURL(server)))
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		assert.NoError(t, client.Connect(ctx))
+		cancel()
+		collected := collect(client.Results())
+		assert.NoError(t, client.SendAudio([]byte("Hello")))
+		assert.NoError(t, client.Close())
+		assert.Len(t, <-collected, 2)
+		assert.NoError(t, client.Err())
+	}
+}
+
+func TestRealtimeClientAnswersPing(t *testing.T) {
+	pong := make(chan string, 1)
+	server := getRealtimeServer(t, func(conn *websocket.Conn, req *http.Request) {
+		conn.SetPongHandler(func(data string) error {
+			pong <- data
+			return nil
+		})
+		conn.WriteMessage(websocket.TextMessage, []byte(sessionBegins))
+		conn.WriteMessage(websocket.PingMessage, []byte("keepalive"))
+		serveSession(conn, req)
+	})
+	defer server.Close()
+	client := NewRealtimeClient("some-token", WithRealtimeURL(realtimeURL(server)))
+
+	assert.NoError(t, client.Connect(context.Background()))
+	collected := collect(client.Results())
+	select {
+	case data := <-pong:
+		assert.Equal(t, "keepalive", data)
+	case <-time.After(time.Second):
+		t.Fatal("ping was not answered")
+	}
+	assert.NoError(t, client.Close())
+	assert.Empty(t, <-collected)
+}
+
+func TestRealtimeClientContextCanceledOnBegin(t *testing.T) {
+	server := getRealtimeServer(t, func(conn *websocket.Conn, req *http.Request) {
+		conn.ReadMessage()
+	})
+	defer server.Close()
+	client := NewRealtimeClient("some-token", WithRealtimeURL(realtimeURL(server)))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	assert.ErrorIs(t, client.Connect(ctx), context.DeadlineExceeded)
+}
+
+func TestRealtimeClientInvalidURL(t *testing.T) {
+	client := NewRealtimeClient("some-token", WithRealtimeURL("https://api.assemblyai.com/v2/realtime/ws"))
+
+	assert.EqualError(t, client.Connect(context.Background()), `unsupported realtime url scheme "https"`)
+}
+
+func TestCreateRealtimeToken(t *testing.T) {
+	var path, authorization string
+	var body map[string]interface{}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		authorization = req.Header.Get("authorization")
+		json.NewDecoder(req.Body).Decode(&body)
+		res.WriteHeader(200)
+		res.Write([]byte(`{"token": "temporary-token"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	token, err := client.CreateRealtimeToken(time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, "temporary-token", token)
+	assert.Equal(t, "/realtime/token", path)
+	assert.Equal(t, "some-token", authorization)
+	assert.Equal(t, map[string]interface{}{"expires_in": float64(3600)}, body)
+}
+
+func TestCreateRealtimeTokenExpiry(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"token": "temporary-token"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	testCases := map[string]struct {
+		expiresIn time.Duration
+		err       string
+	}{
+		"minimum":   {expiresIn: time.Minute},
+		"maximum":   {expiresIn: time.Hour * 100},
+		"too short": {expiresIn: time.Second * 59, err: "expires_in must be between 1m0s and 100h0m0s, got 59s"},
+		"too long":  {expiresIn: time.Hour*100 + time.Second, err: "expires_in must be between 1m0s and 100h0m0s, got 100h0m1s"},
+		"zero":      {expiresIn: 0, err: "expires_in must be between 1m0s and 100h0m0s, got 0s"},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.CreateRealtimeToken(testCase.expiresIn)
+			if testCase.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, testCase.err)
+			}
+		})
+	}
+}
+
+func TestCreateRealtimeTokenError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(401)
+		res.Write([]byte(`{"error": "Authentication error, API token missing/invalid"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "invalid-token", http.DefaultClient)
+
+	token, err := client.CreateRealtimeToken(time.Hour)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	assert.Equal(t, "", token)
+}
+
+func TestCreateRealtimeTokenMissing(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.CreateRealtimeToken(time.Hour)
+	assert.EqualError(t, err, "response did not include a token")
+}
@@ -0,0 +1,101 @@
+package assemblyai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ErrRedactedAudioNotReady is returned by GetRedactedAudio while AssemblyAI is still producing the redacted audio, the request can be retried later.
+var ErrRedactedAudioNotReady = errors.New("redacted audio is not ready yet")
+
+type RedactedAudioResponse struct {
+	Status           string `json:"status"`
+	RedactedAudioUrl string `json:"redacted_audio_url"`
+}
+
+// Fetches the redacted audio of a transcription job submitted with RedactPIIAudio following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/transcript#get-redacted-audio.
+// Returns ErrRedactedAudioNotReady while the redacted audio is still being produced
+func (client *AssemblyAImpl) GetRedactedAudio(id string) (*RedactedAudioResponse, error) {
+	url := fmt.Sprintf("%s/transcript/%s/redacted-audio", client.baseUrl, id)
+	req, err := client.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusAccepted {
+		return nil, ErrRedactedAudioNotReady
+	}
+	data, err := getData[RedactedAudioResponse](resp)
+	if err != nil {
+		return nil, err
+	}
+	if data.RedactedAudioUrl == "" {
+		return nil, errors.New("response did not include a redacted_audio_url")
+	}
+	return data, nil
+}
+
+// Streams the redacted audio of a transcription job submitted with RedactPIIAudio into w.
+// The audio is fetched from the redacted_audio_url without the authorization header, as it is hosted outside of the AssemblyAI API.
+// The timeout of the http client limits the time until the download starts, but not the download of a long recording itself.
+// Returns ErrRedactedAudioNotReady while the redacted audio is still being produced
+func (client *AssemblyAImpl) DownloadRedactedAudio(id string, w io.Writer) error {
+	data, err := client.GetRedactedAudio(id)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", data.RedactedAudioUrl, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", client.userAgent)
+	resp, err := client.startDownload(req, cancel)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if !isValidStatus(resp.StatusCode) {
+		body, err := getBody(resp)
+		if err != nil {
+			return err
+		}
+		return newAPIError(resp.StatusCode, body)
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("could not download redacted audio: %w", err)
+	}
+	return nil
+}
+
+// startDownload sends the request with the timeout of the http client applied until the response headers arrive,
+// cancel must cancel the context of the request.
+func (client *AssemblyAImpl) startDownload(req *http.Request, cancel context.CancelFunc) (*http.Response, error) {
+	download := client.Client
+	download.Timeout = 0
+	timedOut := func() bool { return false }
+	if client.Timeout > 0 {
+		timer := time.AfterFunc(client.Timeout, cancel)
+		timedOut = func() bool { return !timer.Stop() }
+	}
+	resp, err := download.Do(req)
+	if timedOut() {
+		if err == nil {
+			resp.Body.Close()
+		}
+		return nil, fmt.Errorf("could not download redacted audio: no response within %s", client.Timeout)
+	}
+	if err != nil {
+		return nil, redactError(err, client.token)
+	}
+	return resp, nil
+}
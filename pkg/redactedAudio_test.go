@@ -0,0 +1,171 @@
+package assemblyai
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRedactedAudio(t *testing.T) {
+	var path string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"status": "redacted_audio_ready",
+			"redacted_audio_url": "https://s3.us-west-2.amazonaws.com/api.assembly.ai.usw2/redacted-audio/785efd9e.mp3"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetRedactedAudio("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.NoError(t, err)
+	assert.Equal(t, &RedactedAudioResponse{
+		Status:           "redacted_audio_ready",
+		RedactedAudioUrl: "https://s3.us-west-2.amazonaws.com/api.assembly.ai.usw2/redacted-audio/785efd9e.mp3",
+	}, data)
+	assert.Equal(t, "/transcript/5551722-f677-48a6-9287-39c0aafd9ac1/redacted-audio", path)
+}
+
+func TestGetRedactedAudioNotReady(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(202)
+		res.Write([]byte(`{"status": "redacted_audio_not_ready"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetRedactedAudio("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.ErrorIs(t, err, ErrRedactedAudioNotReady)
+	assert.Nil(t, data)
+}
+
+func TestGetRedactedAudioBadRequest(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(400)
+		res.Write([]byte(`{"error": "Redacted audio was not requested"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetRedactedAudio("5551722-f677-48a6-9287-39c0aafd9ac1")
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.NotErrorIs(t, err, ErrRedactedAudioNotReady)
+	assert.Nil(t, data)
+}
+
+func TestDownloadRedactedAudio(t *testing.T) {
+	var serverUrl, fileAuthorization string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/redacted-audio/785efd9e.mp3" {
+			fileAuthorization = req.Header.Get("authorization")
+			res.WriteHeader(200)
+			res.Write([]byte("some-redacted-audio"))
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"status": "redacted_audio_ready",
+			"redacted_audio_url": "` + serverUrl + `/redacted-audio/785efd9e.mp3"
+		}`))
+	})
+	defer server.Close()
+	serverUrl = server.URL
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	var audio bytes.Buffer
+	err := client.DownloadRedactedAudio("5551722-f677-48a6-9287-39c0aafd9ac1", &audio)
+	assert.NoError(t, err)
+	assert.Equal(t, "some-redacted-audio", audio.String())
+	assert.Equal(t, "", fileAuthorization)
+}
+
+func TestDownloadRedactedAudioLongerThanTimeout(t *testing.T) {
+	var serverUrl string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/redacted-audio/785efd9e.mp3" {
+			res.WriteHeader(200)
+			for i := 0; i < 3; i++ {
+				res.Write([]byte("chunk "))
+				res.(http.Flusher).Flush()
+				time.Sleep(time.Millisecond * 100)
+			}
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"status": "redacted_audio_ready", "redacted_audio_url": "` + serverUrl + `/redacted-audio/785efd9e.mp3"}`))
+	})
+	defer server.Close()
+	serverUrl = server.URL
+	client := New(server.URL, "some-token", &http.Client{Timeout: time.Millisecond * 150})
+
+	var audio bytes.Buffer
+	err := client.DownloadRedactedAudio("5551722-f677-48a6-9287-39c0aafd9ac1", &audio)
+	assert.NoError(t, err)
+	assert.Equal(t, "chunk chunk chunk ", audio.String())
+}
+
+func TestDownloadRedactedAudioNoResponse(t *testing.T) {
+	var serverUrl string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/redacted-audio/785efd9e.mp3" {
+			select {
+			case <-req.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"status": "redacted_audio_ready", "redacted_audio_url": "` + serverUrl + `/redacted-audio/785efd9e.mp3"}`))
+	})
+	defer server.Close()
+	serverUrl = server.URL
+	client := New(server.URL, "some-token", &http.Client{Timeout: time.Millisecond * 50})
+
+	var audio bytes.Buffer
+	err := client.DownloadRedactedAudio("5551722-f677-48a6-9287-39c0aafd9ac1", &audio)
+	assert.EqualError(t, err, "could not download redacted audio: no response within 50ms")
+	assert.Equal(t, 0, audio.Len())
+}
+
+func TestDownloadRedactedAudioNotReady(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(202)
+		res.Write([]byte(`{"status": "redacted_audio_not_ready"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	var audio bytes.Buffer
+	err := client.DownloadRedactedAudio("5551722-f677-48a6-9287-39c0aafd9ac1", &audio)
+	assert.ErrorIs(t, err, ErrRedactedAudioNotReady)
+	assert.Equal(t, 0, audio.Len())
+}
+
+func TestDownloadRedactedAudioExpired(t *testing.T) {
+	var serverUrl string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/redacted-audio/785efd9e.mp3" {
+			res.WriteHeader(403)
+			res.Write([]byte("<Error><Code>AccessDenied</Code></Error>"))
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"status": "redacted_audio_ready", "redacted_audio_url": "` + serverUrl + `/redacted-audio/785efd9e.mp3"}`))
+	})
+	defer server.Close()
+	serverUrl = server.URL
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	var audio bytes.Buffer
+	err := client.DownloadRedactedAudio("5551722-f677-48a6-9287-39c0aafd9ac1", &audio)
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 403, apiError.StatusCode)
+	assert.Equal(t, 0, audio.Len())
+}
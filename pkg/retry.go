@@ -0,0 +1,142 @@
+package assemblyai
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig configures the retries of a RetryTransport.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts including the first request, a zero value defaults to 3
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry which doubles with every retry, a zero value defaults to 500 milliseconds
+	BaseDelay time.Duration
+	// RetryableStatusCodes are the response status codes which are retried, nil defaults to 429 and 503
+	RetryableStatusCodes []int
+}
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryBaseDelay   = time.Millisecond * 500
+)
+
+var defaultRetryableStatusCodes = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}
+
+// withDefaults returns a copy of the config where zero values are replaced by the defaults.
+func (config RetryConfig) withDefaults() RetryConfig {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if config.BaseDelay <= 0 {
+		config.BaseDelay = defaultRetryBaseDelay
+	}
+	if config.RetryableStatusCodes == nil {
+		config.RetryableStatusCodes = defaultRetryableStatusCodes
+	}
+	return config
+}
+
+func (config RetryConfig) isRetryableStatus(statusCode int) bool {
+	for _, retryable := range config.RetryableStatusCodes {
+		if statusCode == retryable {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryTransport is a http.RoundTripper which retries network errors and retryable status codes with an exponential backoff.
+// The Retry-After header of a 429 response is honored.
+// Network errors are only retried for idempotent methods like GET and DELETE, as a POST e.g. submitting a transcription job
+// may have been processed before the connection failed and retrying it would create the job twice.
+// Requests with a body which can not be replayed e.g. UploadReader with a plain io.Reader are sent only once.
+// Use it as the transport of the http client passed to New:
+//
+//	client := assemblyai.New(baseUrl, token, &http.Client{Transport: &assemblyai.RetryTransport{}})
+type RetryTransport struct {
+	// Base is the transport performing the requests, nil defaults to http.DefaultTransport
+	Base http.RoundTripper
+	// Config configures the retries
+	Config RetryConfig
+}
+
+func (transport *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := transport.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	config := transport.Config.withDefaults()
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	delay := config.BaseDelay
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+		resp, err := base.RoundTrip(attemptReq)
+		if attempt >= config.MaxAttempts || !replayable || (err != nil && !isIdempotent(req.Method)) {
+			return resp, err
+		}
+		wait := delay
+		if err == nil {
+			if !config.isRetryableStatus(resp.StatusCode) {
+				return resp, nil
+			}
+			if retryAfter, ok := parseRetryAfter(resp); ok {
+				wait = retryAfter
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+// isIdempotent reports whether sending a request with the method twice has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete, http.MethodPut:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses the Retry-After header of a 429 response, which is either in seconds or a http date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	return parseRetryAfterHeader(resp.Header.Get("Retry-After"))
+}
+
+// parseRetryAfterHeader parses the value of a Retry-After header, a date in the past results in a zero wait.
+func parseRetryAfterHeader(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
@@ -0,0 +1,194 @@
+package assemblyai
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func getRetryClient(config RetryConfig) *http.Client {
+	return &http.Client{Transport: &RetryTransport{Config: config}}
+}
+
+func TestRetryTransportRetriesStatusCodes(t *testing.T) {
+	requests := 0
+	bodies := []string{}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		if requests < 3 {
+			res.WriteHeader(503)
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", getRetryClient(RetryConfig{BaseDelay: time.Millisecond}))
+
+	id, err := client.Transcript("https://some-url.com/some-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "5551722-f677-48a6-9287-39c0aafd9ac1", id)
+	assert.Equal(t, 3, requests)
+	for _, body := range bodies {
+		assert.JSONEq(t, `{"audio_url": "https://some-url.com/some-id"}`, body)
+	}
+}
+
+func TestRetryTransportMaxAttempts(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.WriteHeader(503)
+		res.Write([]byte(`{"error": "unavailable"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", getRetryClient(RetryConfig{MaxAttempts: 4, BaseDelay: time.Millisecond}))
+
+	_, err := client.UploadLocalFile([]byte("some audio data"))
+	assert.Error(t, err)
+	assert.Equal(t, 4, requests)
+}
+
+func TestRetryTransportNotRetryableStatus(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.WriteHeader(500)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", getRetryClient(RetryConfig{BaseDelay: time.Millisecond}))
+
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestRetryTransportCustomStatusCodes(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.WriteHeader(502)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", getRetryClient(RetryConfig{BaseDelay: time.Millisecond, RetryableStatusCodes: []int{502}}))
+
+	// the poll loop retries 502 itself, which is disabled to count the requests of the transport
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", &PollSettings{MaxRetries: -1})
+	assert.Error(t, err)
+	assert.Equal(t, 3, requests)
+}
+
+func TestRetryTransportRetryAfter(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests == 1 {
+			res.Header().Set("Retry-After", "1")
+			res.WriteHeader(429)
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed", "text": "Hello"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", getRetryClient(RetryConfig{BaseDelay: time.Millisecond}))
+
+	start := time.Now()
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+	assert.Equal(t, 2, requests)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRetryTransportNetworkError(t *testing.T) {
+	attempts := 0
+	transport := &RetryTransport{
+		Base: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts < 2 {
+				return nil, errors.New("connection reset by peer")
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed"}`)),
+			}, nil
+		}),
+		Config: RetryConfig{BaseDelay: time.Millisecond},
+	}
+	client := New("https://api.assemblyai.com/v2", "some-token", &http.Client{Transport: transport})
+
+	data, err := client.GetTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.NoError(t, err)
+	assert.Equal(t, Completed, data.Status)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryTransportNetworkErrorPostNotRetried(t *testing.T) {
+	errConnection := errors.New("connection reset by peer")
+	attempts := 0
+	transport := &RetryTransport{
+		Base: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			return nil, errConnection
+		}),
+		Config: RetryConfig{BaseDelay: time.Millisecond},
+	}
+	client := New("https://api.assemblyai.com/v2", "some-token", &http.Client{Transport: transport})
+
+	_, err := client.Transcript("https://some-url.com/some-id")
+	assert.ErrorIs(t, err, errConnection)
+	assert.Equal(t, 1, attempts)
+
+	_, err = client.UploadLocalFile([]byte("some audio data"))
+	assert.ErrorIs(t, err, errConnection)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryTransportStreamNotRetried(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		io.ReadAll(req.Body)
+		res.WriteHeader(503)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", getRetryClient(RetryConfig{BaseDelay: time.Millisecond}))
+
+	_, err := client.UploadReader(io.MultiReader(strings.NewReader("some audio data")))
+	assert.Error(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	resp := &http.Response{StatusCode: 429, Header: http.Header{}}
+	_, ok := parseRetryAfter(resp)
+	assert.False(t, ok)
+
+	resp.Header.Set("Retry-After", "3")
+	wait, ok := parseRetryAfter(resp)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second*3, wait)
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	wait, ok = parseRetryAfter(resp)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	resp.StatusCode = 503
+	_, ok = parseRetryAfter(resp)
+	assert.False(t, ok)
+}
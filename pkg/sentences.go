@@ -0,0 +1,38 @@
+package assemblyai
+
+import "fmt"
+
+// Sentence is a single sentence of the transcript, Start and End are in milliseconds.
+type Sentence struct {
+	Text       string  `json:"text"`
+	Start      int     `json:"start"`
+	End        int     `json:"end"`
+	Confidence float64 `json:"confidence"`
+	Words      []Word  `json:"words"`
+	// Speaker is the speaker of the sentence, it is only set if SpeakerLabels was requested
+	Speaker string `json:"speaker"`
+}
+
+type sentencesResponse struct {
+	Sentences []Sentence `json:"sentences"`
+}
+
+// Fetches the sentences of a completed transcription job following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/transcript#get-sentences-in-transcript.
+// Returns the sentences including their timestamps and words, or ErrTranscriptNotCompleted if the job is not completed yet
+func (client *AssemblyAImpl) GetSentences(id string) ([]Sentence, error) {
+	url := fmt.Sprintf("%s/transcript/%s/sentences", client.baseUrl, id)
+	req, err := client.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := getData[sentencesResponse](resp)
+	if err != nil {
+		return nil, client.notCompletedError(id, err)
+	}
+	return data.Sentences, nil
+}
@@ -0,0 +1,64 @@
+package assemblyai
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSentences(t *testing.T) {
+	content, err := os.ReadFile("testdata/transcript_sentences.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var path string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		res.WriteHeader(200)
+		res.Write(content)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	sentences, err := client.GetSentences("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90")
+	assert.NoError(t, err)
+	assert.Equal(t, "/transcript/f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90/sentences", path)
+	assert.Len(t, sentences, 3)
+	assert.Equal(t, "Thanks, happy to be here.", sentences[1].Text)
+	assert.Equal(t, "B", sentences[1].Speaker)
+	assert.Equal(t, Word{Text: "Good", Start: 320, End: 605, Confidence: 0.9792, Speaker: "A"}, sentences[0].Words[0])
+
+	for i, sentence := range sentences {
+		texts := []string{}
+		for _, word := range sentence.Words {
+			texts = append(texts, word.Text)
+		}
+		assert.Equal(t, sentence.Text, strings.Join(texts, " "))
+		assert.Equal(t, sentence.Start, sentence.Words[0].Start)
+		assert.Equal(t, sentence.End, sentence.Words[len(sentence.Words)-1].End)
+		if i > 0 {
+			assert.Less(t, sentences[i-1].End, sentence.Start)
+		}
+	}
+}
+
+func TestGetSentencesNotCompleted(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		if strings.HasSuffix(req.URL.Path, "/sentences") {
+			res.WriteHeader(400)
+			res.Write([]byte(`{"error": "Transcript is not completed yet"}`))
+			return
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", "status": "queued"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	sentences, err := client.GetSentences("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90")
+	assert.ErrorIs(t, err, ErrTranscriptNotCompleted)
+	assert.Nil(t, sentences)
+}
@@ -0,0 +1,105 @@
+package assemblyai
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	defaultMaxCharsPerCaption = 32
+	defaultMaxCaptionDuration = time.Second * 5
+)
+
+// SubtitleOptions configures the locally generated subtitles of SubtitlesFromWords.
+type SubtitleOptions struct {
+	// Format is either SRT or VTT, an empty value defaults to SRT
+	Format SubtitleFormat
+	// MaxCharsPerCaption limits the number of characters of a caption, a zero value defaults to 32 characters
+	MaxCharsPerCaption int
+	// MaxCaptionDuration limits the time a caption is shown, a zero value defaults to 5 seconds
+	MaxCaptionDuration time.Duration
+}
+
+type caption struct {
+	start int
+	end   int
+	words []string
+}
+
+// Generates subtitles from the words of a completed transcription job without another request to AssemblyAI.
+// Captions are split on word boundaries once MaxCharsPerCaption or MaxCaptionDuration would be exceeded,
+// a single word exceeding the limits gets a caption of its own.
+// Returns the subtitle file content in the requested format
+func SubtitlesFromWords(words []Word, opts SubtitleOptions) (string, error) {
+	if opts.Format == "" {
+		opts.Format = SRT
+	}
+	if opts.Format != SRT && opts.Format != VTT {
+		return "", fmt.Errorf("unsupported subtitle format %q", opts.Format)
+	}
+	if opts.MaxCharsPerCaption < 0 || opts.MaxCaptionDuration < 0 {
+		return "", errors.New("subtitle limits must not be negative")
+	}
+	if opts.MaxCharsPerCaption == 0 {
+		opts.MaxCharsPerCaption = defaultMaxCharsPerCaption
+	}
+	if opts.MaxCaptionDuration == 0 {
+		opts.MaxCaptionDuration = defaultMaxCaptionDuration
+	}
+	maxDuration := int(opts.MaxCaptionDuration / time.Millisecond)
+
+	captions := []caption{}
+	current := caption{}
+	length := 0
+	for _, word := range words {
+		if len(current.words) > 0 && (length+1+utf8.RuneCountInString(word.Text) > opts.MaxCharsPerCaption || word.End-current.start > maxDuration) {
+			captions = append(captions, current)
+			current = caption{}
+			length = 0
+		}
+		if len(current.words) == 0 {
+			current.start = word.Start
+			length = utf8.RuneCountInString(word.Text)
+		} else {
+			length += 1 + utf8.RuneCountInString(word.Text)
+		}
+		current.words = append(current.words, word.Text)
+		if word.End > current.end {
+			current.end = word.End
+		}
+	}
+	if len(current.words) > 0 {
+		captions = append(captions, current)
+	}
+
+	var builder strings.Builder
+	if opts.Format == VTT {
+		builder.WriteString("WEBVTT\n\n")
+	}
+	for i, caption := range captions {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		if opts.Format == SRT {
+			fmt.Fprintf(&builder, "%d\n", i+1)
+		}
+		fmt.Fprintf(&builder, "%s --> %s\n%s\n",
+			formatTimestamp(caption.start, opts.Format), formatTimestamp(caption.end, opts.Format), strings.Join(caption.words, " "))
+	}
+	return builder.String(), nil
+}
+
+// formatTimestamp formats milliseconds as HH:MM:SS,mmm for SRT and HH:MM:SS.mmm for VTT.
+func formatTimestamp(milliseconds int, format SubtitleFormat) string {
+	separator := ","
+	if format == VTT {
+		separator = "."
+	}
+	hours := milliseconds / 3600000
+	minutes := milliseconds / 60000 % 60
+	seconds := milliseconds / 1000 % 60
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", hours, minutes, seconds, separator, milliseconds%1000)
+}
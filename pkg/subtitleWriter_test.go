@@ -0,0 +1,109 @@
+package assemblyai
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+func readFixtureWords(t *testing.T, fixture string) []Word {
+	content, err := os.ReadFile(filepath.Join("testdata", fixture))
+	assert.NoError(t, err)
+	var data TranscriptResponse
+	assert.NoError(t, json.Unmarshal(content, &data))
+	return data.Words
+}
+
+func TestSubtitlesFromWordsGolden(t *testing.T) {
+	words := readFixtureWords(t, "transcript_words.json")
+	for _, format := range []SubtitleFormat{SRT, VTT} {
+		t.Run(string(format), func(t *testing.T) {
+			subtitles, err := SubtitlesFromWords(words, SubtitleOptions{Format: format, MaxCharsPerCaption: 42, MaxCaptionDuration: time.Second * 4})
+			assert.NoError(t, err)
+
+			golden := filepath.Join("testdata", "transcript_words."+string(format))
+			if *updateGolden {
+				assert.NoError(t, os.WriteFile(golden, []byte(subtitles), 0644))
+			}
+			expected, err := os.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), subtitles)
+		})
+	}
+}
+
+func TestSubtitlesFromWords(t *testing.T) {
+	words := readFixtureWords(t, "transcript_speaker_labels.json")
+
+	subtitles, err := SubtitlesFromWords(words, SubtitleOptions{MaxCharsPerCaption: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, srtSubtitles, subtitles)
+
+	subtitles, err = SubtitlesFromWords(words, SubtitleOptions{Format: VTT, MaxCharsPerCaption: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, "WEBVTT\n\n00:00:00.250 --> 00:00:01.650\nHello, how are you?\n\n00:00:02.100 --> 00:00:03.400\nI am fine, thanks.\n", subtitles)
+}
+
+func TestSubtitlesFromWordsEdgeCases(t *testing.T) {
+	testCases := []struct {
+		name     string
+		words    []Word
+		opts     SubtitleOptions
+		expected string
+	}{
+		{
+			"identical start and end",
+			[]Word{{Text: "Uh", Start: 500, End: 500}, {Text: "hello", Start: 500, End: 900}},
+			SubtitleOptions{},
+			"1\n00:00:00,500 --> 00:00:00,900\nUh hello\n",
+		},
+		{
+			"gap longer than caption duration",
+			[]Word{{Text: "Hello.", Start: 0, End: 400}, {Text: "Goodbye.", Start: 8000, End: 8600}},
+			SubtitleOptions{MaxCaptionDuration: time.Second * 2},
+			"1\n00:00:00,000 --> 00:00:00,400\nHello.\n\n2\n00:00:08,000 --> 00:00:08,600\nGoodbye.\n",
+		},
+		{
+			"word longer than caption",
+			[]Word{{Text: "a", Start: 0, End: 100}, {Text: "supercalifragilistic", Start: 100, End: 900}, {Text: "word", Start: 900, End: 1000}},
+			SubtitleOptions{MaxCharsPerCaption: 10},
+			"1\n00:00:00,000 --> 00:00:00,100\na\n\n2\n00:00:00,100 --> 00:00:00,900\nsupercalifragilistic\n\n3\n00:00:00,900 --> 00:00:01,000\nword\n",
+		},
+		{
+			"multi-byte characters",
+			[]Word{{Text: "Größe", Start: 0, End: 400}, {Text: "über", Start: 400, End: 800}, {Text: "你好", Start: 800, End: 1200}, {Text: "世界", Start: 1200, End: 1600}},
+			SubtitleOptions{MaxCharsPerCaption: 10},
+			"1\n00:00:00,000 --> 00:00:00,800\nGröße über\n\n2\n00:00:00,800 --> 00:00:01,600\n你好 世界\n",
+		},
+		{
+			"final caption ends at the last word",
+			[]Word{{Text: "One", Start: 3599000, End: 3599500}, {Text: "hour.", Start: 3599600, End: 3601250}},
+			SubtitleOptions{Format: VTT},
+			"WEBVTT\n\n00:59:59.000 --> 01:00:01.250\nOne hour.\n",
+		},
+		{"no words", nil, SubtitleOptions{}, ""},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			subtitles, err := SubtitlesFromWords(testCase.words, testCase.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, subtitles)
+		})
+	}
+}
+
+func TestSubtitlesFromWordsInvalidOptions(t *testing.T) {
+	_, err := SubtitlesFromWords(nil, SubtitleOptions{Format: "txt"})
+	assert.Error(t, err)
+	_, err = SubtitlesFromWords(nil, SubtitleOptions{MaxCharsPerCaption: -1})
+	assert.Error(t, err)
+	_, err = SubtitlesFromWords(nil, SubtitleOptions{MaxCaptionDuration: -time.Second})
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package assemblyai
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type SubtitleFormat string
+
+const (
+	SRT SubtitleFormat = "srt"
+	VTT SubtitleFormat = "vtt"
+)
+
+// Exports the completed transcription job as subtitles following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/transcript#export-srt-or-vtt-caption-files.
+// format is either SRT or VTT.
+// charsPerCaption defines the maximum number of characters per caption, 0 uses the AssemblyAI default
+// Returns the raw subtitle file content
+func (client *AssemblyAImpl) GetSubtitles(id string, format SubtitleFormat, charsPerCaption int) (string, error) {
+	if format != SRT && format != VTT {
+		return "", fmt.Errorf("unsupported subtitle format %q", format)
+	}
+	if charsPerCaption < 0 {
+		return "", fmt.Errorf("chars per caption must not be negative, got %d", charsPerCaption)
+	}
+	subtitleUrl := fmt.Sprintf("%s/transcript/%s/%s", client.baseUrl, id, format)
+	if charsPerCaption > 0 {
+		subtitleUrl += "?" + url.Values{"chars_per_caption": {strconv.Itoa(charsPerCaption)}}.Encode()
+	}
+	req, err := client.newRequest("GET", subtitleUrl, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := getBody(resp)
+	if err != nil {
+		return "", err
+	}
+	if !isValidStatus(resp.StatusCode) {
+		return "", newAPIError(resp.StatusCode, body)
+	}
+	return string(body), nil
+}
@@ -0,0 +1,131 @@
+package assemblyai
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const srtSubtitles = `1
+00:00:00,250 --> 00:00:01,650
+Hello, how are you?
+
+2
+00:00:02,100 --> 00:00:03,400
+I am fine, thanks.
+`
+
+const vttSubtitles = `WEBVTT
+
+00:00.250 --> 00:01.650
+Hello, how are you?
+
+00:02.100 --> 00:03.400
+I am fine, thanks.
+`
+
+func TestGetSubtitles(t *testing.T) {
+	var path, authorization string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.String()
+		authorization = req.Header.Get("authorization")
+		res.WriteHeader(200)
+		res.Write([]byte(srtSubtitles))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	subtitles, err := client.GetSubtitles("5551722-f677-48a6-9287-39c0aafd9ac1", SRT, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, srtSubtitles, subtitles)
+	assert.Equal(t, "/transcript/5551722-f677-48a6-9287-39c0aafd9ac1/srt", path)
+	assert.Equal(t, "some-token", authorization)
+}
+
+func TestGetSubtitlesFormats(t *testing.T) {
+	testCases := map[SubtitleFormat]string{
+		SRT: srtSubtitles,
+		VTT: vttSubtitles,
+	}
+	for format, content := range testCases {
+		t.Run(string(format), func(t *testing.T) {
+			var path string
+			server := getServer(func(res http.ResponseWriter, req *http.Request) {
+				path = req.URL.Path
+				res.Header().Set("Content-Type", "text/plain")
+				res.WriteHeader(200)
+				res.Write([]byte(content))
+			})
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			subtitles, err := client.GetSubtitles("5551722-f677-48a6-9287-39c0aafd9ac1", format, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, content, subtitles)
+			assert.Equal(t, "/transcript/5551722-f677-48a6-9287-39c0aafd9ac1/"+string(format), path)
+		})
+	}
+}
+
+func TestGetSubtitlesCharsPerCaption(t *testing.T) {
+	var path string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.String()
+		res.WriteHeader(200)
+		res.Write([]byte("WEBVTT\n"))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	subtitles, err := client.GetSubtitles("5551722-f677-48a6-9287-39c0aafd9ac1", VTT, 32)
+	assert.NoError(t, err)
+	assert.Equal(t, "WEBVTT\n", subtitles)
+	assert.Equal(t, "/transcript/5551722-f677-48a6-9287-39c0aafd9ac1/vtt?chars_per_caption=32", path)
+}
+
+func TestGetSubtitlesInvalidArguments(t *testing.T) {
+	called := false
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.GetSubtitles("5551722-f677-48a6-9287-39c0aafd9ac1", "txt", 0)
+	assert.Error(t, err)
+	_, err = client.GetSubtitles("5551722-f677-48a6-9287-39c0aafd9ac1", SRT, -1)
+	assert.Error(t, err)
+	assert.False(t, called)
+}
+
+func TestGetSubtitlesAPIError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(404)
+		res.Write([]byte(`{"error": "Transcript not found"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	subtitles, err := client.GetSubtitles("5551722-f677-48a6-9287-39c0aafd9ac1", SRT, 0)
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 404, apiError.StatusCode)
+	assert.Equal(t, "", subtitles)
+}
+
+func TestGetSubtitlesNotCompleted(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(400)
+		res.Write([]byte(`{"error": "Transcript is not completed yet"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	subtitles, err := client.GetSubtitles("5551722-f677-48a6-9287-39c0aafd9ac1", VTT, 0)
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 400, apiError.StatusCode)
+	assert.Equal(t, "Transcript is not completed yet", apiError.Message)
+	assert.Equal(t, "", subtitles)
+}
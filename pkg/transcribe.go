@@ -0,0 +1,102 @@
+package assemblyai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Uploads the file at path, submits it for transcription with the optional cfg and polls the job until it is completed.
+// Errors are wrapped with the step that failed, the underlying error can be inspected with errors.As and errors.Is.
+// Returns the transcribed text
+func (client *AssemblyAImpl) TranscribeFile(path string, cfg *TranscriptConfig, poll *PollSettings) (string, error) {
+	uploadUrl, err := client.UploadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("upload failed: %w", err)
+	}
+	id, err := client.TranscriptWithOptions(uploadUrl, cfg)
+	if err != nil {
+		return "", fmt.Errorf("transcript submission failed: %w", err)
+	}
+	text, err := client.PollTranscript(id, poll)
+	if err != nil {
+		return "", fmt.Errorf("polling transcript %s failed: %w", id, err)
+	}
+	return text, nil
+}
+
+// Submits the remote audioUrl for transcription with the optional cfg and polls the job until it is completed.
+// Errors are wrapped with the step that failed like in TranscribeFile.
+// Returns the full transcript response of the completed job
+func (client *AssemblyAImpl) TranscribeURL(audioUrl string, cfg *TranscriptConfig, poll *PollSettings) (*TranscriptResponse, error) {
+	return client.TranscribeURLContext(context.Background(), audioUrl, cfg, poll)
+}
+
+// Submits the remote audioUrl for transcription like TranscribeURL, but stops as soon as ctx is done.
+// The submission request is cancelled with ctx and polling stops like in PollTranscriptContext.
+// Returns the full transcript response of the completed job or an error matching ctx.Err() if ctx is done first
+func (client *AssemblyAImpl) TranscribeURLContext(ctx context.Context, audioUrl string, cfg *TranscriptConfig, poll *PollSettings) (*TranscriptResponse, error) {
+	data, err := client.submitTranscript(ctx, audioUrl, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("transcript submission failed: %w", err)
+	}
+	id := data.Id
+	data, err = client.pollTranscriptFull(ctx, id, poll)
+	if err != nil {
+		return nil, fmt.Errorf("polling transcript %s failed: %w", id, err)
+	}
+	return data, nil
+}
+
+// BatchResult is the outcome of transcribing a single audio url of TranscribeBatch, either Transcript or Err is set.
+type BatchResult struct {
+	AudioUrl   string
+	Transcript *TranscriptResponse
+	Err        error
+}
+
+// Transcribes every audio url like TranscribeURL with at most concurrency jobs being submitted and polled at the same time.
+// The jobs are polled with the default poll settings of the client, a failed job does not stop the others.
+// Returns a result for every url in the order of urls, the error is only set if the arguments are invalid
+func (client *AssemblyAImpl) TranscribeBatch(urls []string, cfg *TranscriptConfig, concurrency int) ([]BatchResult, error) {
+	return client.TranscribeBatchContext(context.Background(), urls, cfg, concurrency)
+}
+
+// Transcribes every audio url like TranscribeBatch, but stops as soon as ctx is done.
+// The jobs in progress stop like in TranscribeURLContext and the urls not started yet are not submitted.
+// Returns a result for every url in the order of urls, the urls not completed before ctx is done fail with an error matching ctx.Err()
+func (client *AssemblyAImpl) TranscribeBatchContext(ctx context.Context, urls []string, cfg *TranscriptConfig, concurrency int) ([]BatchResult, error) {
+	if concurrency < 1 {
+		return nil, fmt.Errorf("concurrency must be at least 1, got %d", concurrency)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 {
+		return nil, errors.New("no audio urls to transcribe")
+	}
+	results := make([]BatchResult, len(urls))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < concurrency && worker < len(urls); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					results[i] = BatchResult{AudioUrl: urls[i], Err: err}
+					continue
+				}
+				data, err := client.TranscribeURLContext(ctx, urls[i], cfg, nil)
+				results[i] = BatchResult{AudioUrl: urls[i], Transcript: data, Err: err}
+			}
+		}()
+	}
+	for i := range urls {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results, nil
+}
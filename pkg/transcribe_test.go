@@ -0,0 +1,251 @@
+package assemblyai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// getTranscribeServer returns a fake server handling uploads, submissions and polls of a single transcription job.
+func getTranscribeServer(t *testing.T, uploadStatus, submitStatus int, pollBody string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/upload", func(res http.ResponseWriter, req *http.Request) {
+		io.ReadAll(req.Body)
+		res.WriteHeader(uploadStatus)
+		res.Write([]byte(`{"upload_url": "https://cdn.assemblyai.com/upload/some-id"}`))
+	})
+	mux.HandleFunc("/transcript", func(res http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		assert.JSONEq(t, `{"audio_url": "https://cdn.assemblyai.com/upload/some-id", "speaker_labels": true}`, string(body))
+		res.WriteHeader(submitStatus)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+	})
+	mux.HandleFunc("/transcript/5551722-f677-48a6-9287-39c0aafd9ac1", func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(pollBody))
+	})
+	return httptest.NewServer(mux)
+}
+
+func writeAudioFile(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "audio.mp3")
+	assert.NoError(t, os.WriteFile(path, []byte("some audio data"), 0600))
+	return path
+}
+
+func TestTranscribeFile(t *testing.T) {
+	server := getTranscribeServer(t, 200, 200, `{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed", "text": "Hello"}`)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	text, err := client.TranscribeFile(writeAudioFile(t), &TranscriptConfig{SpeakerLabels: true}, &PollSettings{Frequency: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+}
+
+func TestTranscribeFileErrors(t *testing.T) {
+	completed := `{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed", "text": "Hello"}`
+	testCases := []struct {
+		name         string
+		uploadStatus int
+		submitStatus int
+		pollBody     string
+		message      string
+	}{
+		{"upload", 500, 200, completed, "upload failed"},
+		{"submission", 200, 400, completed, "transcript submission failed"},
+		{"poll", 200, 200, `{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "error", "error": "Download error"}`, "polling transcript 5551722-f677-48a6-9287-39c0aafd9ac1 failed"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := getTranscribeServer(t, testCase.uploadStatus, testCase.submitStatus, testCase.pollBody)
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			text, err := client.TranscribeFile(writeAudioFile(t), &TranscriptConfig{SpeakerLabels: true}, nil)
+			assert.ErrorContains(t, err, testCase.message)
+			assert.Equal(t, "", text)
+		})
+	}
+}
+
+func TestTranscribeFileMissingFile(t *testing.T) {
+	client := New("http://localhost", "some-token", http.DefaultClient)
+
+	_, err := client.TranscribeFile(filepath.Join(t.TempDir(), "missing.mp3"), nil, nil)
+	var fileError *FileError
+	assert.ErrorAs(t, err, &fileError)
+	assert.ErrorContains(t, err, "upload failed")
+}
+
+func TestTranscribeURL(t *testing.T) {
+	server := getTranscribeServer(t, 200, 200, `{
+		"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+		"status": "completed",
+		"text": "Hello",
+		"utterances": [{"speaker": "A", "text": "Hello", "start": 0, "end": 500, "confidence": 0.9}]
+	}`)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.TranscribeURL("https://cdn.assemblyai.com/upload/some-id", &TranscriptConfig{SpeakerLabels: true}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "5551722-f677-48a6-9287-39c0aafd9ac1", data.Id)
+	assert.Equal(t, "Hello", data.Text)
+	assert.Len(t, data.Utterances, 1)
+}
+
+func TestTranscribeURLErrors(t *testing.T) {
+	server := getTranscribeServer(t, 200, 400, "")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.TranscribeURL("https://cdn.assemblyai.com/upload/some-id", &TranscriptConfig{SpeakerLabels: true}, nil)
+	assert.ErrorContains(t, err, "transcript submission failed")
+	assert.Nil(t, data)
+
+	server = getTranscribeServer(t, 200, 200, `{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`)
+	defer server.Close()
+	client = New(server.URL, "some-token", http.DefaultClient)
+
+	data, err = client.TranscribeURL("https://cdn.assemblyai.com/upload/some-id", &TranscriptConfig{SpeakerLabels: true}, &PollSettings{Frequency: time.Millisecond, Timeout: time.Millisecond * 10})
+	assert.ErrorContains(t, err, "polling transcript 5551722-f677-48a6-9287-39c0aafd9ac1 failed")
+	assert.Nil(t, data)
+}
+
+func TestTranscribeURLContextCanceled(t *testing.T) {
+	server := getTranscribeServer(t, 200, 200, `{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	start := time.Now()
+	data, err := client.TranscribeURLContext(ctx, "https://cdn.assemblyai.com/upload/some-id", &TranscriptConfig{SpeakerLabels: true}, &PollSettings{Frequency: time.Millisecond * 10})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "polling transcript 5551722-f677-48a6-9287-39c0aafd9ac1 failed")
+	assert.Nil(t, data)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+// getBatchServer returns a fake server completing a job for every submitted audio url, audio urls ending with missing.mp3 fail.
+// maxActive records the maximum number of jobs being submitted or polled at the same time.
+func getBatchServer(t *testing.T, maxActive *int) *httptest.Server {
+	var mutex sync.Mutex
+	active := 0
+	return getServer(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method == "POST" {
+			var body struct {
+				AudioUrl string `json:"audio_url"`
+			}
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			mutex.Lock()
+			active++
+			if active > *maxActive {
+				*maxActive = active
+			}
+			mutex.Unlock()
+			res.WriteHeader(200)
+			res.Write([]byte(fmt.Sprintf(`{"id": "%s", "status": "queued"}`, filepath.Base(body.AudioUrl))))
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+		mutex.Lock()
+		active--
+		mutex.Unlock()
+		id := filepath.Base(req.URL.Path)
+		res.WriteHeader(200)
+		if id == "missing.mp3" {
+			res.Write([]byte(`{"id": "missing.mp3", "status": "error", "error": "Download error, unable to download missing.mp3"}`))
+			return
+		}
+		res.Write([]byte(fmt.Sprintf(`{"id": "%s", "status": "completed", "text": "%s"}`, id, strings.TrimSuffix(id, ".mp3"))))
+	})
+}
+
+func TestTranscribeBatch(t *testing.T) {
+	maxActive := 0
+	server := getBatchServer(t, &maxActive)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	urls := []string{}
+	for i := 0; i < 6; i++ {
+		urls = append(urls, fmt.Sprintf("https://some-url.com/episode-%d.mp3", i))
+	}
+	urls[3] = "https://some-url.com/missing.mp3"
+
+	results, err := client.TranscribeBatch(urls, nil, 2)
+	assert.NoError(t, err)
+	assert.Len(t, results, 6)
+	for i, result := range results {
+		assert.Equal(t, urls[i], result.AudioUrl)
+		if i == 3 {
+			assert.ErrorContains(t, result.Err, "Download error")
+			assert.Nil(t, result.Transcript)
+			continue
+		}
+		assert.NoError(t, result.Err)
+		assert.Equal(t, fmt.Sprintf("episode-%d", i), result.Transcript.Text)
+	}
+	assert.Equal(t, 2, maxActive)
+}
+
+func TestTranscribeBatchContextCanceled(t *testing.T) {
+	var mutex sync.Mutex
+	submitted := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method == "POST" {
+			mutex.Lock()
+			submitted++
+			mutex.Unlock()
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	urls := []string{"https://some-url.com/episode-0.mp3", "https://some-url.com/episode-1.mp3", "https://some-url.com/episode-2.mp3"}
+	start := time.Now()
+	results, err := client.TranscribeBatchContext(ctx, urls, nil, 1)
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Len(t, results, 3)
+	for i, result := range results {
+		assert.Equal(t, urls[i], result.AudioUrl)
+		assert.ErrorIs(t, result.Err, context.DeadlineExceeded)
+		assert.Nil(t, result.Transcript)
+	}
+	assert.Equal(t, 1, submitted)
+}
+
+func TestTranscribeBatchInvalidArguments(t *testing.T) {
+	called := false
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.TranscribeBatch([]string{"https://some-url.com/episode.mp3"}, nil, 0)
+	assert.ErrorContains(t, err, "concurrency")
+	_, err = client.TranscribeBatch(nil, nil, 2)
+	assert.Error(t, err)
+	_, err = client.TranscribeBatch([]string{"https://some-url.com/episode.mp3"}, &TranscriptConfig{LanguageCode: "xx"}, 2)
+	assert.ErrorContains(t, err, "language_code")
+	assert.False(t, called)
+}
@@ -0,0 +1,133 @@
+package assemblyai
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ListParams filters the transcription jobs returned by ListTranscripts, zero values are not sent.
+type ListParams struct {
+	// Limit is the maximum number of transcripts per page
+	Limit int
+	// Status only returns transcripts with the given status
+	Status TranscriptionStatus
+	// CreatedOn only returns transcripts created on the date of the given time
+	CreatedOn time.Time
+	// BeforeId only returns transcripts created before the transcript with the given id
+	BeforeId string
+	// AfterId only returns transcripts created after the transcript with the given id
+	AfterId string
+	// ThrottledOnly only returns throttled transcripts
+	ThrottledOnly bool
+}
+
+func (params ListParams) query() url.Values {
+	query := url.Values{}
+	if params.Limit > 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Status != "" {
+		query.Set("status", string(params.Status))
+	}
+	if !params.CreatedOn.IsZero() {
+		query.Set("created_on", params.CreatedOn.Format("2006-01-02"))
+	}
+	if params.BeforeId != "" {
+		query.Set("before_id", params.BeforeId)
+	}
+	if params.AfterId != "" {
+		query.Set("after_id", params.AfterId)
+	}
+	if params.ThrottledOnly {
+		query.Set("throttled_only", "true")
+	}
+	return query
+}
+
+type TranscriptList struct {
+	Transcripts []TranscriptSummary `json:"transcripts"`
+	PageDetails PageDetails         `json:"page_details"`
+}
+
+// TranscriptSummary is a transcription job as listed by ListTranscripts.
+type TranscriptSummary struct {
+	Id          string              `json:"id"`
+	ResourceUrl string              `json:"resource_url"`
+	Status      TranscriptionStatus `json:"status"`
+	AudioUrl    string              `json:"audio_url"`
+	Created     string              `json:"created"`
+	Completed   string              `json:"completed"`
+	Error       string              `json:"error"`
+}
+
+// PageDetails contains the cursor urls of the previous and next page, which are empty on the first and last page.
+type PageDetails struct {
+	Limit       int    `json:"limit"`
+	ResultCount int    `json:"result_count"`
+	CurrentUrl  string `json:"current_url"`
+	PrevUrl     string `json:"prev_url"`
+	NextUrl     string `json:"next_url"`
+}
+
+// Lists the transcription jobs following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/transcript#list-transcripts.
+// Returns a page of transcripts, ordered from newest to oldest
+func (client *AssemblyAImpl) ListTranscripts(params ListParams) (*TranscriptList, error) {
+	return client.listTranscripts(context.Background(), params)
+}
+
+// Lists the transcription jobs like ListTranscripts and follows the prev_url cursor to the older pages.
+// fn is called for every transcript from newest to oldest, listing stops once fn returns false or there are no more pages.
+// Returns an error if a page can not be fetched, the context is cancelled or the API returns a cursor twice
+func (client *AssemblyAImpl) ListAllTranscripts(ctx context.Context, params ListParams, fn func(transcript TranscriptSummary) bool) error {
+	seen := map[string]bool{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		list, err := client.listTranscripts(ctx, params)
+		if err != nil {
+			return err
+		}
+		for _, transcript := range list.Transcripts {
+			if !fn(transcript) {
+				return nil
+			}
+		}
+		if list.PageDetails.PrevUrl == "" {
+			return nil
+		}
+		prevUrl, err := url.Parse(list.PageDetails.PrevUrl)
+		if err != nil {
+			return fmt.Errorf("invalid prev_url %q: %w", list.PageDetails.PrevUrl, err)
+		}
+		beforeId := prevUrl.Query().Get("before_id")
+		if beforeId == "" {
+			return fmt.Errorf("prev_url %q does not contain a before_id", list.PageDetails.PrevUrl)
+		}
+		if seen[beforeId] {
+			return fmt.Errorf("pagination cursor %s was returned twice", beforeId)
+		}
+		seen[beforeId] = true
+		params.BeforeId = beforeId
+	}
+}
+
+func (client *AssemblyAImpl) listTranscripts(ctx context.Context, params ListParams) (*TranscriptList, error) {
+	listUrl := client.baseUrl + "/transcript"
+	if query := params.query(); len(query) > 0 {
+		listUrl += "?" + query.Encode()
+	}
+	req, err := client.newRequest("GET", listUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return getData[TranscriptList](resp)
+}
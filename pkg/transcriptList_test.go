@@ -0,0 +1,255 @@
+package assemblyai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTranscripts(t *testing.T) {
+	content, err := os.ReadFile("testdata/transcript_list.json")
+	assert.NoError(t, err)
+	var path string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.String()
+		res.WriteHeader(200)
+		res.Write(content)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	list, err := client.ListTranscripts(ListParams{Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "/transcript?limit=2", path)
+	assert.Len(t, list.Transcripts, 2)
+	assert.Equal(t, TranscriptSummary{
+		Id:          "ba8f1f1e-fc1d-4a4e-9b5e-1a2b3c4d5e6f",
+		ResourceUrl: "https://api.assemblyai.com/v2/transcript/ba8f1f1e-fc1d-4a4e-9b5e-1a2b3c4d5e6f",
+		Status:      "completed",
+		AudioUrl:    "https://assembly.ai/wildfires.mp3",
+		Created:     "2023-11-02T21:49:25.586965",
+		Completed:   "2023-11-02T21:49:53.325129",
+	}, list.Transcripts[0])
+	assert.Equal(t, Err, list.Transcripts[1].Status)
+	assert.Equal(t, "Download error, unable to download https://assembly.ai/missing.mp3", list.Transcripts[1].Error)
+	assert.Equal(t, PageDetails{
+		Limit:       2,
+		ResultCount: 2,
+		CurrentUrl:  "https://api.assemblyai.com/v2/transcript?limit=2",
+		PrevUrl:     "https://api.assemblyai.com/v2/transcript?limit=2&before_id=a7c5cafd-2c2e-4bdd-b0b2-69dade2f7a1b",
+	}, list.PageDetails)
+}
+
+func TestListTranscriptsPage(t *testing.T) {
+	content, err := os.ReadFile("testdata/transcript_list_page.json")
+	assert.NoError(t, err)
+	var query string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		query = req.URL.Query().Get("limit")
+		res.WriteHeader(200)
+		res.Write(content)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	list, err := client.ListTranscripts(ListParams{Limit: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", query)
+	assert.Equal(t, 3, list.PageDetails.Limit)
+	assert.Equal(t, 3, list.PageDetails.ResultCount)
+	assert.Equal(t, "https://api.assemblyai.com/v2/transcript?limit=3&before_id=5f0d8a2e-7c1b-4e3a-9d6f-2b4c6e8a0d1f", list.PageDetails.PrevUrl)
+	assert.Equal(t, "https://api.assemblyai.com/v2/transcript?limit=3&after_id=9b2c4d6e-1f3a-4b5c-8d7e-0a1b2c3d4e5f", list.PageDetails.NextUrl)
+
+	statuses := []TranscriptionStatus{}
+	for _, transcript := range list.Transcripts {
+		statuses = append(statuses, transcript.Status)
+	}
+	assert.Equal(t, []TranscriptionStatus{Queued, Processing, Completed}, statuses)
+	assert.Equal(t, "", list.Transcripts[0].Completed)
+	assert.Equal(t, "https://assembly.ai/interview.mp3", list.Transcripts[1].AudioUrl)
+	assert.Equal(t, "2023-11-03T07:59:02.871205", list.Transcripts[2].Completed)
+}
+
+func TestListTranscriptsQuery(t *testing.T) {
+	var path string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.String()
+		res.WriteHeader(200)
+		res.Write([]byte(`{"page_details": {}, "transcripts": []}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.ListTranscripts(ListParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, "/transcript", path)
+
+	_, err = client.ListTranscripts(ListParams{Limit: 10, Status: Completed, AfterId: "ba8f1f1e-fc1d-4a4e-9b5e-1a2b3c4d5e6f"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/transcript?after_id=ba8f1f1e-fc1d-4a4e-9b5e-1a2b3c4d5e6f&limit=10&status=completed", path)
+}
+
+func TestListTranscriptsFilters(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   ListParams
+		expected string
+	}{
+		{"no filters", ListParams{}, "/transcript"},
+		{"failed jobs", ListParams{Status: Err}, "/transcript?status=error"},
+		{
+			"created on",
+			ListParams{CreatedOn: time.Date(2023, 11, 2, 23, 59, 0, 0, time.UTC)},
+			"/transcript?created_on=2023-11-02",
+		},
+		{
+			"failed jobs of a day",
+			ListParams{Status: Err, CreatedOn: time.Date(2023, 11, 2, 0, 0, 0, 0, time.UTC), Limit: 100},
+			"/transcript?created_on=2023-11-02&limit=100&status=error",
+		},
+		{
+			"before id",
+			ListParams{BeforeId: "a7c5cafd-2c2e-4bdd-b0b2-69dade2f7a1b", Limit: 5},
+			"/transcript?before_id=a7c5cafd-2c2e-4bdd-b0b2-69dade2f7a1b&limit=5",
+		},
+		{
+			"before and after id",
+			ListParams{BeforeId: "a7c5cafd-2c2e-4bdd-b0b2-69dade2f7a1b", AfterId: "ba8f1f1e-fc1d-4a4e-9b5e-1a2b3c4d5e6f"},
+			"/transcript?after_id=ba8f1f1e-fc1d-4a4e-9b5e-1a2b3c4d5e6f&before_id=a7c5cafd-2c2e-4bdd-b0b2-69dade2f7a1b",
+		},
+		{"throttled only", ListParams{ThrottledOnly: true}, "/transcript?throttled_only=true"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var path string
+			server := getServer(func(res http.ResponseWriter, req *http.Request) {
+				path = req.URL.String()
+				res.WriteHeader(200)
+				res.Write([]byte(`{"page_details": {}, "transcripts": []}`))
+			})
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			_, err := client.ListTranscripts(testCase.params)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, path)
+		})
+	}
+}
+
+func TestListTranscriptsUnauthorized(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(401)
+		res.Write([]byte(`{"error": "Authentication error, API token missing/invalid"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	list, err := client.ListTranscripts(ListParams{})
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 401, apiError.StatusCode)
+	assert.Nil(t, list)
+}
+
+// getPagedServer returns a fake server serving the given pages of transcript ids from newest to oldest, linked by their prev_url.
+func getPagedServer(pages [][]string, requests *[]string) *httptest.Server {
+	return getServer(func(res http.ResponseWriter, req *http.Request) {
+		*requests = append(*requests, req.URL.RawQuery)
+		page := 0
+		for i := range pages {
+			if i > 0 && req.URL.Query().Get("before_id") == pages[i-1][len(pages[i-1])-1] {
+				page = i
+			}
+		}
+		transcripts := ""
+		for i, id := range pages[page] {
+			if i > 0 {
+				transcripts += ","
+			}
+			transcripts += fmt.Sprintf(`{"id": "%s", "status": "completed"}`, id)
+		}
+		prevUrl := "null"
+		if page < len(pages)-1 {
+			prevUrl = fmt.Sprintf(`"https://api.assemblyai.com/v2/transcript?limit=2&before_id=%s"`, pages[page][len(pages[page])-1])
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(fmt.Sprintf(`{"page_details": {"prev_url": %s}, "transcripts": [%s]}`, prevUrl, transcripts)))
+	})
+}
+
+func TestListAllTranscripts(t *testing.T) {
+	requests := []string{}
+	server := getPagedServer([][]string{{"id-6", "id-5"}, {"id-4", "id-3"}, {"id-2", "id-1"}}, &requests)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	ids := []string{}
+	err := client.ListAllTranscripts(context.Background(), ListParams{Limit: 2}, func(transcript TranscriptSummary) bool {
+		ids = append(ids, transcript.Id)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id-6", "id-5", "id-4", "id-3", "id-2", "id-1"}, ids)
+	assert.Equal(t, []string{"limit=2", "before_id=id-5&limit=2", "before_id=id-3&limit=2"}, requests)
+}
+
+func TestListAllTranscriptsStop(t *testing.T) {
+	requests := []string{}
+	server := getPagedServer([][]string{{"id-6", "id-5"}, {"id-4", "id-3"}, {"id-2", "id-1"}}, &requests)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	ids := []string{}
+	err := client.ListAllTranscripts(context.Background(), ListParams{Limit: 2}, func(transcript TranscriptSummary) bool {
+		ids = append(ids, transcript.Id)
+		return transcript.Id != "id-4"
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id-6", "id-5", "id-4"}, ids)
+	assert.Len(t, requests, 2)
+}
+
+func TestListAllTranscriptsRepeatedCursor(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"page_details": {"prev_url": "https://api.assemblyai.com/v2/transcript?limit=1&before_id=id-1"},
+			"transcripts": [{"id": "id-1", "status": "completed"}]
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	err := client.ListAllTranscripts(context.Background(), ListParams{Limit: 1}, func(transcript TranscriptSummary) bool {
+		return true
+	})
+	assert.ErrorContains(t, err, "returned twice")
+	assert.Equal(t, 2, requests)
+}
+
+func TestListAllTranscriptsContextCancelled(t *testing.T) {
+	requests := []string{}
+	server := getPagedServer([][]string{{"id-6", "id-5"}, {"id-4", "id-3"}, {"id-2", "id-1"}}, &requests)
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := client.ListAllTranscripts(ctx, ListParams{Limit: 2}, func(transcript TranscriptSummary) bool {
+		if transcript.Id == "id-5" {
+			cancel()
+		}
+		return true
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, requests, 1)
+}
@@ -0,0 +1,345 @@
+package assemblyai
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// TranscriptOptions configures a transcription job following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/transcript.
+// Fields left at their zero value are not sent, so AssemblyAI applies its own defaults.
+type TranscriptOptions struct {
+	// SpeechModel selects the model used for the transcription, an empty value uses the AssemblyAI default
+	SpeechModel SpeechModel `json:"speech_model,omitempty"`
+	// SpeakerLabels enables speaker diarization
+	SpeakerLabels bool `json:"speaker_labels,omitempty"`
+	// SpeakersExpected hints the number of speakers between 1 and 10, it requires SpeakerLabels to be enabled
+	SpeakersExpected int `json:"speakers_expected,omitempty"`
+	// Punctuate enables automatic punctuation, nil keeps the AssemblyAI default (true)
+	Punctuate *bool `json:"punctuate,omitempty"`
+	// FormatText enables text formatting e.g. casing and numbers, nil keeps the AssemblyAI default (true)
+	FormatText *bool `json:"format_text,omitempty"`
+	// DualChannel transcribes each channel of a stereo recording separately, the words and utterances carry their Channel
+	// without requiring SpeakerLabels
+	DualChannel bool `json:"dual_channel,omitempty"`
+	// Disfluencies keeps filler words like "um" and "uh" in the transcript, nil keeps the AssemblyAI default (false)
+	Disfluencies *bool `json:"disfluencies,omitempty"`
+	// LanguageCode sets the language of the audio e.g. "de", an empty value lets AssemblyAI use its default
+	LanguageCode string `json:"language_code,omitempty"`
+	// LanguageDetection lets AssemblyAI detect the dominant language of the audio, it can not be combined with LanguageCode
+	LanguageDetection bool `json:"language_detection,omitempty"`
+	// LanguageConfidenceThreshold between 0 and 1 lets the job fail if the detected language confidence is below it
+	LanguageConfidenceThreshold float64 `json:"language_confidence_threshold,omitempty"`
+	// SpeechThreshold between 0 and 1 lets the job fail with ErrSpeechThresholdNotMet if the audio contains a smaller share of speech,
+	// nil keeps the AssemblyAI default of transcribing any audio
+	SpeechThreshold *float64 `json:"speech_threshold,omitempty"`
+	// WordBoost contains words and phrases which are more likely to be transcribed
+	WordBoost []string `json:"word_boost,omitempty"`
+	// BoostParam controls how much weight is applied to WordBoost, an empty value uses the AssemblyAI default
+	BoostParam BoostParam `json:"boost_param,omitempty"`
+	// AudioStartFrom is the time in milliseconds at which the transcription starts, nil starts at the beginning
+	AudioStartFrom *int `json:"audio_start_from,omitempty"`
+	// AudioEndAt is the time in milliseconds at which the transcription ends, nil ends at the end of the audio
+	AudioEndAt *int `json:"audio_end_at,omitempty"`
+	// FilterProfanity masks profanity in the transcript with asterisks e.g. "s***", nil keeps the AssemblyAI default (false)
+	FilterProfanity *bool `json:"filter_profanity,omitempty"`
+	// RedactPII redacts personally identifiable information from the transcript, it requires RedactPIIPolicies
+	RedactPII bool `json:"redact_pii,omitempty"`
+	// RedactPIIPolicies defines which kind of information is redacted if RedactPII is enabled
+	RedactPIIPolicies []PIIPolicy `json:"redact_pii_policies,omitempty"`
+	// RedactPIISub defines how redacted information is replaced, an empty value uses the AssemblyAI default (hash)
+	RedactPIISub PIISubstitution `json:"redact_pii_sub,omitempty"`
+	// RedactPIIAudio creates a copy of the audio with the redacted information beeped out, see GetRedactedAudio
+	RedactPIIAudio bool `json:"redact_pii_audio,omitempty"`
+	// AutoChapters summarizes the audio in chapters with timestamps
+	AutoChapters bool `json:"auto_chapters,omitempty"`
+	// SentimentAnalysis detects the sentiment of each sentence
+	SentimentAnalysis bool `json:"sentiment_analysis,omitempty"`
+	// EntityDetection detects entities like names, locations and organizations
+	EntityDetection bool `json:"entity_detection,omitempty"`
+	// IABCategories detects the topics of the audio using the IAB taxonomy
+	IABCategories bool `json:"iab_categories,omitempty"`
+	// ContentSafety detects sensitive content like hate speech or violence
+	ContentSafety bool `json:"content_safety,omitempty"`
+	// AutoHighlights detects the key phrases of the audio
+	AutoHighlights bool `json:"auto_highlights,omitempty"`
+	// Summarization summarizes the audio, it can not be combined with AutoChapters
+	Summarization bool `json:"summarization,omitempty"`
+	// SummaryModel is the model used for Summarization, an empty value uses the AssemblyAI default
+	SummaryModel SummaryModel `json:"summary_model,omitempty"`
+	// SummaryType is the format of the summary, an empty value uses the AssemblyAI default
+	SummaryType SummaryType `json:"summary_type,omitempty"`
+	// CustomSpelling replaces words of the transcript with a custom spelling
+	CustomSpelling []SpellingRule `json:"custom_spelling,omitempty"`
+	// WebhookURL is called by AssemblyAI once the transcription job is completed or failed
+	WebhookURL string `json:"webhook_url,omitempty"`
+	// WebhookAuthHeaderName is the name of the header AssemblyAI sends with the webhook request
+	WebhookAuthHeaderName string `json:"webhook_auth_header_name,omitempty"`
+	// WebhookAuthHeaderValue is the secret value of the WebhookAuthHeaderName header, it requires WebhookAuthHeaderName to be set
+	WebhookAuthHeaderValue string `json:"webhook_auth_header_value,omitempty"`
+}
+
+// maxWordBoost is the maximum number of words and phrases AssemblyAI accepts in word_boost.
+const maxWordBoost = 1000
+
+// minSpeakersExpected and maxSpeakersExpected define the range AssemblyAI accepts for speakers_expected.
+const (
+	minSpeakersExpected = 1
+	maxSpeakersExpected = 10
+)
+
+// BoostParam is the weight applied to the words and phrases of word_boost.
+type BoostParam string
+
+const (
+	BoostParamLow     BoostParam = "low"
+	BoostParamDefault BoostParam = "default"
+	BoostParamHigh    BoostParam = "high"
+)
+
+// PIIPolicy is a kind of personally identifiable information which can be redacted https://www.assemblyai.com/docs/audio-intelligence/pii-redaction.
+type PIIPolicy string
+
+const (
+	PIIMedicalProcess         PIIPolicy = "medical_process"
+	PIIMedicalCondition       PIIPolicy = "medical_condition"
+	PIIBloodType              PIIPolicy = "blood_type"
+	PIIDrug                   PIIPolicy = "drug"
+	PIIInjury                 PIIPolicy = "injury"
+	PIINumberSequence         PIIPolicy = "number_sequence"
+	PIIEmailAddress           PIIPolicy = "email_address"
+	PIIDateOfBirth            PIIPolicy = "date_of_birth"
+	PIIPhoneNumber            PIIPolicy = "phone_number"
+	PIIUSSocialSecurityNumber PIIPolicy = "us_social_security_number"
+	PIICreditCardNumber       PIIPolicy = "credit_card_number"
+	PIICreditCardExpiration   PIIPolicy = "credit_card_expiration"
+	PIICreditCardCVV          PIIPolicy = "credit_card_cvv"
+	PIIDate                   PIIPolicy = "date"
+	PIINationality            PIIPolicy = "nationality"
+	PIIEvent                  PIIPolicy = "event"
+	PIILanguage               PIIPolicy = "language"
+	PIILocation               PIIPolicy = "location"
+	PIIMoneyAmount            PIIPolicy = "money_amount"
+	PIIPersonName             PIIPolicy = "person_name"
+	PIIPersonAge              PIIPolicy = "person_age"
+	PIIOrganization           PIIPolicy = "organization"
+	PIIPoliticalAffiliation   PIIPolicy = "political_affiliation"
+	PIIOccupation             PIIPolicy = "occupation"
+	PIIReligion               PIIPolicy = "religion"
+	PIIDriversLicense         PIIPolicy = "drivers_license"
+	PIIBankingInformation     PIIPolicy = "banking_information"
+)
+
+// PIISubstitution defines how redacted information is replaced in the transcript.
+type PIISubstitution string
+
+const (
+	// PIISubHash replaces the redacted information with "#" characters
+	PIISubHash PIISubstitution = "hash"
+	// PIISubEntityName replaces the redacted information with its policy name e.g. "[PERSON_NAME]"
+	PIISubEntityName PIISubstitution = "entity_name"
+)
+
+// SpeechModel is the model used to transcribe the audio https://www.assemblyai.com/docs/speech-to-text/speech-recognition#select-the-speech-model-with-best-and-nano.
+type SpeechModel string
+
+const (
+	SpeechModelBest SpeechModel = "best"
+	SpeechModelNano SpeechModel = "nano"
+)
+
+// SummaryModel is the model used for summarization https://www.assemblyai.com/docs/audio-intelligence/summarization.
+type SummaryModel string
+
+const (
+	SummaryModelInformative    SummaryModel = "informative"
+	SummaryModelConversational SummaryModel = "conversational"
+	SummaryModelCatchy         SummaryModel = "catchy"
+)
+
+type SummaryType string
+
+const (
+	SummaryTypeBullets        SummaryType = "bullets"
+	SummaryTypeBulletsVerbose SummaryType = "bullets_verbose"
+	SummaryTypeGist           SummaryType = "gist"
+	SummaryTypeHeadline       SummaryType = "headline"
+	SummaryTypeParagraph      SummaryType = "paragraph"
+)
+
+// summaryTypesByModel contains the summary types supported by each summary model.
+var summaryTypesByModel = map[SummaryModel][]SummaryType{
+	SummaryModelInformative:    {SummaryTypeBullets, SummaryTypeBulletsVerbose, SummaryTypeHeadline, SummaryTypeParagraph},
+	SummaryModelConversational: {SummaryTypeBullets, SummaryTypeBulletsVerbose, SummaryTypeHeadline, SummaryTypeParagraph},
+	SummaryModelCatchy:         {SummaryTypeHeadline, SummaryTypeGist},
+}
+
+// validateSummarization checks the documented restrictions of the summarization options.
+func (opts *TranscriptOptions) validateSummarization() error {
+	if !opts.Summarization {
+		if opts.SummaryModel != "" || opts.SummaryType != "" {
+			return errors.New("summary_model and summary_type require summarization to be enabled")
+		}
+		return nil
+	}
+	if opts.AutoChapters {
+		return errors.New("summarization can not be combined with auto_chapters")
+	}
+	if opts.SummaryModel == "" {
+		return nil
+	}
+	summaryTypes, ok := summaryTypesByModel[opts.SummaryModel]
+	if !ok {
+		return fmt.Errorf("unsupported summary_model %q", opts.SummaryModel)
+	}
+	if opts.SummaryModel == SummaryModelConversational && !opts.SpeakerLabels && !opts.DualChannel {
+		return errors.New("summary_model conversational requires speaker_labels or dual_channel")
+	}
+	if opts.SummaryType == "" {
+		return nil
+	}
+	for _, summaryType := range summaryTypes {
+		if summaryType == opts.SummaryType {
+			return nil
+		}
+	}
+	return fmt.Errorf("summary_type %q is not supported by summary_model %q", opts.SummaryType, opts.SummaryModel)
+}
+
+// SpellingRule replaces every word of From with the single word To.
+type SpellingRule struct {
+	From []string `json:"from"`
+	To   string   `json:"to"`
+}
+
+// Bool returns a pointer to v, to set the optional boolean fields like Punctuate and FormatText.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to v, to set the optional integer fields like AudioStartFrom and AudioEndAt.
+func Int(v int) *int {
+	return &v
+}
+
+// Float64 returns a pointer to v, to set the optional float fields like SpeechThreshold.
+func Float64(v float64) *float64 {
+	return &v
+}
+
+// TranscriptConfig is an alias of TranscriptOptions used by TranscriptWithConfig.
+type TranscriptConfig = TranscriptOptions
+
+// languageCodeRegex matches the format of the language codes of AssemblyAI e.g. "de" or "en_us", the supported codes
+// are not checked as AssemblyAI adds languages over time https://www.assemblyai.com/docs/concepts/supported-languages.
+var languageCodeRegex = regexp.MustCompile(`^([a-z]{2,3})(_[a-z]{2})?$`)
+
+// iso639Languages contains the two letter ISO 639-1 language codes, three letter codes only have their format checked.
+var iso639Languages = map[string]bool{
+	"aa": true, "ab": true, "ae": true, "af": true, "ak": true, "am": true, "an": true, "ar": true, "as": true, "av": true, "ay": true, "az": true, "ba": true, "be": true, "bg": true, "bi": true,
+	"bm": true, "bn": true, "bo": true, "br": true, "bs": true, "ca": true, "ce": true, "ch": true, "co": true, "cr": true, "cs": true, "cu": true, "cv": true, "cy": true, "da": true, "de": true,
+	"dv": true, "dz": true, "ee": true, "el": true, "en": true, "eo": true, "es": true, "et": true, "eu": true, "fa": true, "ff": true, "fi": true, "fj": true, "fo": true, "fr": true, "fy": true,
+	"ga": true, "gd": true, "gl": true, "gn": true, "gu": true, "gv": true, "ha": true, "he": true, "hi": true, "ho": true, "hr": true, "ht": true, "hu": true, "hy": true, "hz": true, "ia": true,
+	"id": true, "ie": true, "ig": true, "ii": true, "ik": true, "io": true, "is": true, "it": true, "iu": true, "ja": true, "jv": true, "ka": true, "kg": true, "ki": true, "kj": true, "kk": true,
+	"kl": true, "km": true, "kn": true, "ko": true, "kr": true, "ks": true, "ku": true, "kv": true, "kw": true, "ky": true, "la": true, "lb": true, "lg": true, "li": true, "ln": true, "lo": true,
+	"lt": true, "lu": true, "lv": true, "mg": true, "mh": true, "mi": true, "mk": true, "ml": true, "mn": true, "mr": true, "ms": true, "mt": true, "my": true, "na": true, "nb": true, "nd": true,
+	"ne": true, "ng": true, "nl": true, "nn": true, "no": true, "nr": true, "nv": true, "ny": true, "oc": true, "oj": true, "om": true, "or": true, "os": true, "pa": true, "pi": true, "pl": true,
+	"ps": true, "pt": true, "qu": true, "rm": true, "rn": true, "ro": true, "ru": true, "rw": true, "sa": true, "sc": true, "sd": true, "se": true, "sg": true, "si": true, "sk": true, "sl": true,
+	"sm": true, "sn": true, "so": true, "sq": true, "sr": true, "ss": true, "st": true, "su": true, "sv": true, "sw": true, "ta": true, "te": true, "tg": true, "th": true, "ti": true, "tk": true,
+	"tl": true, "tn": true, "to": true, "tr": true, "ts": true, "tt": true, "tw": true, "ty": true, "ug": true, "uk": true, "ur": true, "uz": true, "ve": true, "vi": true, "vo": true, "wa": true,
+	"wo": true, "xh": true, "yi": true, "yo": true, "za": true, "zh": true, "zu": true,
+}
+
+// validLanguageCode reports whether code has the format of a language code and its language exists.
+func validLanguageCode(code string) bool {
+	match := languageCodeRegex.FindStringSubmatch(code)
+	if match == nil {
+		return false
+	}
+	return len(match[1]) == 3 || iso639Languages[match[1]]
+}
+
+// validate checks the options for values AssemblyAI would reject, so no request has to be made.
+func (opts *TranscriptOptions) validate() error {
+	if opts == nil {
+		return nil
+	}
+	if opts.SpeechModel != "" && opts.SpeechModel != SpeechModelBest && opts.SpeechModel != SpeechModelNano {
+		return fmt.Errorf("unsupported speech_model %q", opts.SpeechModel)
+	}
+	if opts.SpeakersExpected != 0 {
+		if !opts.SpeakerLabels {
+			return errors.New("speakers_expected requires speaker_labels to be enabled")
+		}
+		if opts.SpeakersExpected < minSpeakersExpected || opts.SpeakersExpected > maxSpeakersExpected {
+			return fmt.Errorf("speakers_expected must be between %d and %d, got %d", minSpeakersExpected, maxSpeakersExpected, opts.SpeakersExpected)
+		}
+	}
+	if opts.LanguageDetection && opts.LanguageCode != "" {
+		return errors.New("language_detection can not be combined with language_code")
+	}
+	if opts.LanguageCode != "" && !validLanguageCode(opts.LanguageCode) {
+		return fmt.Errorf("unsupported language_code %q", opts.LanguageCode)
+	}
+	if opts.LanguageConfidenceThreshold < 0 || opts.LanguageConfidenceThreshold > 1 {
+		return fmt.Errorf("language_confidence_threshold must be between 0 and 1, got %v", opts.LanguageConfidenceThreshold)
+	}
+	if opts.SpeechThreshold != nil && (*opts.SpeechThreshold < 0 || *opts.SpeechThreshold > 1) {
+		return fmt.Errorf("speech_threshold must be between 0 and 1, got %v", *opts.SpeechThreshold)
+	}
+	if len(opts.WordBoost) > maxWordBoost {
+		return fmt.Errorf("word_boost must not contain more than %d entries, got %d", maxWordBoost, len(opts.WordBoost))
+	}
+	for i, word := range opts.WordBoost {
+		if strings.TrimSpace(word) == "" {
+			return fmt.Errorf("word_boost entry %d is empty", i)
+		}
+	}
+	if opts.BoostParam != "" && opts.BoostParam != BoostParamLow && opts.BoostParam != BoostParamDefault && opts.BoostParam != BoostParamHigh {
+		return fmt.Errorf("unsupported boost_param %q, expected low, default or high", opts.BoostParam)
+	}
+	if opts.AudioStartFrom != nil && *opts.AudioStartFrom < 0 {
+		return fmt.Errorf("audio_start_from must not be negative, got %d", *opts.AudioStartFrom)
+	}
+	if opts.AudioEndAt != nil && *opts.AudioEndAt < 0 {
+		return fmt.Errorf("audio_end_at must not be negative, got %d", *opts.AudioEndAt)
+	}
+	if opts.AudioStartFrom != nil && opts.AudioEndAt != nil && *opts.AudioStartFrom >= *opts.AudioEndAt {
+		return fmt.Errorf("audio_start_from (%d) must be before audio_end_at (%d)", *opts.AudioStartFrom, *opts.AudioEndAt)
+	}
+	if opts.RedactPII && len(opts.RedactPIIPolicies) == 0 {
+		return errors.New("redact_pii requires at least one redact_pii_policies entry, e.g. PIIPersonName")
+	}
+	if opts.RedactPIIAudio && !opts.RedactPII {
+		return errors.New("redact_pii_audio requires redact_pii to be enabled")
+	}
+	if opts.RedactPIISub != "" {
+		if !opts.RedactPII {
+			return errors.New("redact_pii_sub requires redact_pii to be enabled")
+		}
+		if opts.RedactPIISub != PIISubHash && opts.RedactPIISub != PIISubEntityName {
+			return fmt.Errorf("unsupported redact_pii_sub %q", opts.RedactPIISub)
+		}
+	}
+	for i, rule := range opts.CustomSpelling {
+		if len(rule.From) == 0 {
+			return fmt.Errorf("custom_spelling rule %d has no from words", i)
+		}
+		for _, from := range rule.From {
+			if strings.TrimSpace(from) == "" {
+				return fmt.Errorf("custom_spelling rule %d contains an empty from word", i)
+			}
+		}
+		if fields := strings.Fields(rule.To); len(fields) != 1 || fields[0] != rule.To {
+			return fmt.Errorf("custom_spelling rule %d must replace with a single word, got %q", i, rule.To)
+		}
+	}
+	if err := opts.validateSummarization(); err != nil {
+		return err
+	}
+	if opts.WebhookAuthHeaderValue != "" && opts.WebhookAuthHeaderName == "" {
+		return errors.New("webhook_auth_header_value requires webhook_auth_header_name to be set")
+	}
+	return nil
+}
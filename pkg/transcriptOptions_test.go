@@ -0,0 +1,569 @@
+package assemblyai
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// submitWithOptions submits a transcription job against a fake server and returns the received request body.
+func submitWithOptions(t *testing.T, opts *TranscriptOptions) (string, error) {
+	var body []byte
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		body, _ = io.ReadAll(req.Body)
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "queued"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	id, err := client.TranscriptWithOptions("https://some-url.com/some-id", opts)
+	if err == nil {
+		assert.Equal(t, "5551722-f677-48a6-9287-39c0aafd9ac1", id)
+	}
+	return string(body), err
+}
+
+func TestTranscriptWithOptionsBody(t *testing.T) {
+	enabled := true
+	disabled := false
+	testCases := []struct {
+		name string
+		opts *TranscriptOptions
+		body string
+	}{
+		{"nil options", nil, `{"audio_url": "https://some-url.com/some-id"}`},
+		{"empty options", &TranscriptOptions{}, `{"audio_url": "https://some-url.com/some-id"}`},
+		{"speaker labels", &TranscriptOptions{SpeakerLabels: true}, `{"audio_url": "https://some-url.com/some-id", "speaker_labels": true}`},
+		{"punctuate disabled", &TranscriptOptions{Punctuate: &disabled}, `{"audio_url": "https://some-url.com/some-id", "punctuate": false}`},
+		{
+			"combined",
+			&TranscriptOptions{SpeakerLabels: true, Punctuate: &enabled, FormatText: &disabled},
+			`{"audio_url": "https://some-url.com/some-id", "speaker_labels": true, "punctuate": true, "format_text": false}`,
+		},
+		{
+			"webhook",
+			&TranscriptOptions{WebhookURL: "https://example.com/webhook", WebhookAuthHeaderName: "X-Webhook-Secret", WebhookAuthHeaderValue: "some-secret"},
+			`{
+				"audio_url": "https://some-url.com/some-id",
+				"webhook_url": "https://example.com/webhook",
+				"webhook_auth_header_name": "X-Webhook-Secret",
+				"webhook_auth_header_value": "some-secret"
+			}`,
+		},
+		{
+			"audio trimming",
+			&TranscriptOptions{AudioStartFrom: Int(600000), AudioEndAt: Int(900000)},
+			`{"audio_url": "https://some-url.com/some-id", "audio_start_from": 600000, "audio_end_at": 900000}`,
+		},
+		{"audio start from zero", &TranscriptOptions{AudioStartFrom: Int(0)}, `{"audio_url": "https://some-url.com/some-id", "audio_start_from": 0}`},
+		{"audio end at only", &TranscriptOptions{AudioEndAt: Int(30000)}, `{"audio_url": "https://some-url.com/some-id", "audio_end_at": 30000}`},
+		{
+			"pii redaction",
+			&TranscriptOptions{RedactPII: true, RedactPIIPolicies: []PIIPolicy{PIIUSSocialSecurityNumber, PIICreditCardNumber, PIIPhoneNumber}},
+			`{
+				"audio_url": "https://some-url.com/some-id",
+				"redact_pii": true,
+				"redact_pii_policies": ["us_social_security_number", "credit_card_number", "phone_number"]
+			}`,
+		},
+		{
+			"pii audio redaction",
+			&TranscriptOptions{RedactPII: true, RedactPIIPolicies: []PIIPolicy{PIIPersonName}, RedactPIIAudio: true},
+			`{
+				"audio_url": "https://some-url.com/some-id",
+				"redact_pii": true,
+				"redact_pii_policies": ["person_name"],
+				"redact_pii_audio": true
+			}`,
+		},
+		{"auto chapters", &TranscriptOptions{AutoChapters: true}, `{"audio_url": "https://some-url.com/some-id", "auto_chapters": true}`},
+		{"sentiment analysis", &TranscriptOptions{SentimentAnalysis: true}, `{"audio_url": "https://some-url.com/some-id", "sentiment_analysis": true}`},
+		{"entity detection", &TranscriptOptions{EntityDetection: true}, `{"audio_url": "https://some-url.com/some-id", "entity_detection": true}`},
+		{"iab categories", &TranscriptOptions{IABCategories: true}, `{"audio_url": "https://some-url.com/some-id", "iab_categories": true}`},
+		{"content safety", &TranscriptOptions{ContentSafety: true}, `{"audio_url": "https://some-url.com/some-id", "content_safety": true}`},
+		{"auto highlights", &TranscriptOptions{AutoHighlights: true}, `{"audio_url": "https://some-url.com/some-id", "auto_highlights": true}`},
+		{
+			"summarization",
+			&TranscriptOptions{Summarization: true, SummaryModel: SummaryModelInformative, SummaryType: SummaryTypeBullets},
+			`{"audio_url": "https://some-url.com/some-id", "summarization": true, "summary_model": "informative", "summary_type": "bullets"}`,
+		},
+		{
+			"custom spelling",
+			&TranscriptOptions{CustomSpelling: []SpellingRule{
+				{From: []string{"cube and eighties", "kubernetes"}, To: "Kubernetes"},
+				{From: []string{"assembly ai"}, To: "AssemblyAI"},
+			}},
+			`{
+				"audio_url": "https://some-url.com/some-id",
+				"custom_spelling": [
+					{"from": ["cube and eighties", "kubernetes"], "to": "Kubernetes"},
+					{"from": ["assembly ai"], "to": "AssemblyAI"}
+				]
+			}`,
+		},
+		{"disfluencies", &TranscriptOptions{Disfluencies: Bool(true)}, `{"audio_url": "https://some-url.com/some-id", "disfluencies": true}`},
+		{"without disfluencies", &TranscriptOptions{Disfluencies: Bool(false)}, `{"audio_url": "https://some-url.com/some-id", "disfluencies": false}`},
+		{
+			"disfluencies with other options",
+			&TranscriptOptions{Disfluencies: Bool(true), SpeakerLabels: true, Punctuate: Bool(false), LanguageCode: "en"},
+			`{"audio_url": "https://some-url.com/some-id", "disfluencies": true, "speaker_labels": true, "punctuate": false, "language_code": "en"}`,
+		},
+		{"speech model best", &TranscriptOptions{SpeechModel: SpeechModelBest}, `{"audio_url": "https://some-url.com/some-id", "speech_model": "best"}`},
+		{"speech model nano", &TranscriptOptions{SpeechModel: SpeechModelNano}, `{"audio_url": "https://some-url.com/some-id", "speech_model": "nano"}`},
+		{
+			"speakers expected",
+			&TranscriptOptions{SpeakerLabels: true, SpeakersExpected: 3},
+			`{"audio_url": "https://some-url.com/some-id", "speaker_labels": true, "speakers_expected": 3}`,
+		},
+		{
+			"pii redaction with entity name substitution",
+			&TranscriptOptions{RedactPII: true, RedactPIIPolicies: []PIIPolicy{PIIPersonName, PIIMedicalCondition}, RedactPIISub: PIISubEntityName},
+			`{
+				"audio_url": "https://some-url.com/some-id",
+				"redact_pii": true,
+				"redact_pii_policies": ["person_name", "medical_condition"],
+				"redact_pii_sub": "entity_name"
+			}`,
+		},
+		{"filter profanity", &TranscriptOptions{FilterProfanity: Bool(true)}, `{"audio_url": "https://some-url.com/some-id", "filter_profanity": true}`},
+		{
+			"filter profanity with pii redaction",
+			&TranscriptOptions{FilterProfanity: Bool(true), RedactPII: true, RedactPIIPolicies: []PIIPolicy{PIIPersonName}, RedactPIISub: PIISubEntityName},
+			`{
+				"audio_url": "https://some-url.com/some-id",
+				"filter_profanity": true,
+				"redact_pii": true,
+				"redact_pii_policies": ["person_name"],
+				"redact_pii_sub": "entity_name"
+			}`,
+		},
+		{"dual channel", &TranscriptOptions{DualChannel: true}, `{"audio_url": "https://some-url.com/some-id", "dual_channel": true}`},
+		{
+			"dual channel with speaker labels off",
+			&TranscriptOptions{DualChannel: true, SpeakerLabels: false, Summarization: true, SummaryModel: SummaryModelConversational},
+			`{"audio_url": "https://some-url.com/some-id", "dual_channel": true, "summarization": true, "summary_model": "conversational"}`,
+		},
+		{"language code", &TranscriptOptions{LanguageCode: "de"}, `{"audio_url": "https://some-url.com/some-id", "language_code": "de"}`},
+		{
+			"language detection",
+			&TranscriptOptions{LanguageDetection: true, LanguageConfidenceThreshold: 0.8},
+			`{"audio_url": "https://some-url.com/some-id", "language_detection": true, "language_confidence_threshold": 0.8}`,
+		},
+		{"speech threshold", &TranscriptOptions{SpeechThreshold: Float64(0.5)}, `{"audio_url": "https://some-url.com/some-id", "speech_threshold": 0.5}`},
+		{"zero speech threshold", &TranscriptOptions{SpeechThreshold: Float64(0)}, `{"audio_url": "https://some-url.com/some-id", "speech_threshold": 0}`},
+		{
+			"word boost without boost param",
+			&TranscriptOptions{WordBoost: []string{"AssemblyAI", "Kubernetes"}},
+			`{"audio_url": "https://some-url.com/some-id", "word_boost": ["AssemblyAI", "Kubernetes"]}`,
+		},
+		{
+			"word boost with boost param",
+			&TranscriptOptions{WordBoost: []string{"AssemblyAI"}, BoostParam: BoostParamHigh},
+			`{"audio_url": "https://some-url.com/some-id", "word_boost": ["AssemblyAI"], "boost_param": "high"}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			body, err := submitWithOptions(t, testCase.opts)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.body, body)
+		})
+	}
+}
+
+func TestTranscriptWithOptionsBadRequest(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(400)
+		res.Write([]byte(`{"error": "invalid option"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	id, err := client.TranscriptWithOptions("https://some-url.com/some-id", &TranscriptOptions{SpeakerLabels: true})
+	assert.Error(t, err)
+	assert.Equal(t, "", id)
+}
+
+func TestTranscriptWithOptionsLanguageCode(t *testing.T) {
+	body, err := submitWithOptions(t, &TranscriptOptions{LanguageCode: "de"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(body, `"language_code"`))
+
+	body, err = submitWithOptions(t, &TranscriptOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, body, "language_code")
+}
+
+func TestTranscriptWithOptionsInvalidLanguageCode(t *testing.T) {
+	called := false
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	id, err := client.TranscriptWithOptions("https://some-url.com/some-id", &TranscriptOptions{LanguageCode: "zz"})
+	assert.EqualError(t, err, `unsupported language_code "zz"`)
+	assert.Equal(t, "", id)
+	for _, code := range []string{"xx_us", "english", "EN", "en-US", "en_"} {
+		_, err := client.TranscriptWithOptions("https://some-url.com/some-id", &TranscriptOptions{LanguageCode: code})
+		assert.EqualError(t, err, fmt.Sprintf("unsupported language_code %q", code))
+	}
+	assert.False(t, called)
+}
+
+func TestTranscriptWithOptionsUnlistedLanguageCode(t *testing.T) {
+	for _, code := range []string{"sv", "yue", "pt_br"} {
+		body, err := submitWithOptions(t, &TranscriptOptions{LanguageCode: code})
+		assert.NoError(t, err)
+		assert.JSONEq(t, fmt.Sprintf(`{"audio_url": "https://some-url.com/some-id", "language_code": %q}`, code), body)
+	}
+}
+
+func TestTranscriptWithOptionsLanguageDetectionWithLanguageCode(t *testing.T) {
+	called := false
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	id, err := client.TranscriptWithOptions("https://some-url.com/some-id", &TranscriptOptions{LanguageDetection: true, LanguageCode: "de"})
+	assert.ErrorContains(t, err, "language_detection can not be combined with language_code")
+	assert.Equal(t, "", id)
+	assert.False(t, called)
+}
+
+func TestTranscriptWithOptionsInvalidLanguageConfidenceThreshold(t *testing.T) {
+	for _, threshold := range []float64{-0.1, 1.5} {
+		_, err := submitWithOptions(t, &TranscriptOptions{LanguageDetection: true, LanguageConfidenceThreshold: threshold})
+		assert.Error(t, err)
+	}
+}
+
+func TestTranscriptWithOptionsInvalidSpeechThreshold(t *testing.T) {
+	for _, threshold := range []float64{-0.1, 1.01} {
+		_, err := submitWithOptions(t, &TranscriptOptions{SpeechThreshold: Float64(threshold)})
+		assert.ErrorContains(t, err, "speech_threshold")
+	}
+}
+
+func TestTranscriptWithOptionsInvalidWordBoost(t *testing.T) {
+	testCases := map[string][]string{
+		"empty word": {"AssemblyAI", ""},
+		"blank word": {"   "},
+		"too many":   make([]string, 1001),
+	}
+	for name, wordBoost := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := submitWithOptions(t, &TranscriptOptions{WordBoost: wordBoost})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTranscriptWithOptionsInvalidBoostParam(t *testing.T) {
+	for _, boostParam := range []BoostParam{"medium", "HIGH", " "} {
+		t.Run(string(boostParam), func(t *testing.T) {
+			_, err := submitWithOptions(t, &TranscriptOptions{WordBoost: []string{"tachycardia"}, BoostParam: boostParam})
+			assert.ErrorContains(t, err, "boost_param")
+		})
+	}
+}
+
+func TestTranscriptWithConfig(t *testing.T) {
+	var body []byte
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		body, _ = io.ReadAll(req.Body)
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "queued"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	punctuate := false
+	id, err := client.TranscriptWithConfig("https://some-url.com/some-id", TranscriptConfig{
+		SpeakerLabels: true,
+		LanguageCode:  "en_us",
+		Punctuate:     &punctuate,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "5551722-f677-48a6-9287-39c0aafd9ac1", id)
+	assert.JSONEq(t, `{
+		"audio_url": "https://some-url.com/some-id",
+		"speaker_labels": true,
+		"language_code": "en_us",
+		"punctuate": false
+	}`, string(body))
+}
+
+func TestTranscriptWithOptionsPunctuateFormatText(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts *TranscriptOptions
+		body string
+	}{
+		{"unset", &TranscriptOptions{}, `{"audio_url": "https://some-url.com/some-id"}`},
+		{
+			"explicit false",
+			&TranscriptOptions{Punctuate: Bool(false), FormatText: Bool(false)},
+			`{"audio_url": "https://some-url.com/some-id", "punctuate": false, "format_text": false}`,
+		},
+		{
+			"explicit true",
+			&TranscriptOptions{Punctuate: Bool(true), FormatText: Bool(true)},
+			`{"audio_url": "https://some-url.com/some-id", "punctuate": true, "format_text": true}`,
+		},
+		{
+			"format text only",
+			&TranscriptOptions{FormatText: Bool(false)},
+			`{"audio_url": "https://some-url.com/some-id", "format_text": false}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			body, err := submitWithOptions(t, testCase.opts)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.body, body)
+		})
+	}
+}
+
+func TestTranscriptBodyUnchanged(t *testing.T) {
+	var body []byte
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		body, _ = io.ReadAll(req.Body)
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.Transcript("https://some-url.com/some-id")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"audio_url": "https://some-url.com/some-id"}`, string(body))
+}
+
+func TestTranscriptWithOptionsWebhookAuthValueWithoutName(t *testing.T) {
+	called := false
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	id, err := client.TranscriptWithOptions("https://some-url.com/some-id", &TranscriptOptions{
+		WebhookURL:             "https://example.com/webhook",
+		WebhookAuthHeaderValue: "some-secret",
+	})
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "some-secret")
+	assert.Equal(t, "", id)
+	assert.False(t, called)
+}
+
+func TestTranscriptWithOptionsWebhookSecretNotLogged(t *testing.T) {
+	requests := 0
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		body, _ := io.ReadAll(req.Body)
+		switch requests {
+		case 1:
+			res.WriteHeader(400)
+			res.Write(body)
+		case 2:
+			res.WriteHeader(200)
+			res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "queued"}`))
+		default:
+			conn, _, _ := res.(http.Hijacker).Hijack()
+			conn.Close()
+		}
+	})
+	defer server.Close()
+	events := []LogEvent{}
+	client := NewClient("some-token", WithBaseURL(server.URL), WithLogger(func(event LogEvent) {
+		events = append(events, event)
+	}))
+	opts := &TranscriptOptions{
+		WebhookURL:             "https://example.com/webhook",
+		WebhookAuthHeaderName:  "X-Webhook-Secret",
+		WebhookAuthHeaderValue: "some-secret",
+	}
+
+	_, err := client.TranscriptWithOptions("https://some-url.com/some-id", opts)
+	assert.Error(t, err)
+	_, err = client.TranscriptWithOptions("https://some-url.com/some-id", opts)
+	assert.NoError(t, err)
+	_, err = client.TranscriptWithOptions("https://some-url.com/some-id", opts)
+	assert.Error(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, 400, events[0].StatusCode)
+	assert.Error(t, events[2].Err)
+	for _, event := range events {
+		assert.NotContains(t, event.URL, "some-secret")
+		for name, values := range event.Header {
+			assert.NotContains(t, name, "X-Webhook-Secret")
+			for _, value := range values {
+				assert.NotContains(t, value, "some-secret")
+			}
+		}
+		if event.Err != nil {
+			assert.NotContains(t, event.Err.Error(), "some-secret")
+		}
+	}
+}
+
+func TestTranscriptWithOptionsWebhookFallbackPolling(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "completed",
+			"text": "Hello",
+			"webhook_url": "https://example.com/webhook",
+			"webhook_auth": true
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	id, err := client.TranscriptWithOptions("https://some-url.com/some-id", &TranscriptOptions{
+		WebhookURL:             "https://example.com/webhook",
+		WebhookAuthHeaderName:  "X-Webhook-Secret",
+		WebhookAuthHeaderValue: "some-secret",
+	})
+	assert.NoError(t, err)
+	text, err := client.PollTranscript(id, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+}
+
+func TestTranscriptWithOptionsInvalidAudioTrimming(t *testing.T) {
+	testCases := map[string]*TranscriptOptions{
+		"negative start":   {AudioStartFrom: Int(-1)},
+		"negative end":     {AudioEndAt: Int(-1)},
+		"start after end":  {AudioStartFrom: Int(900000), AudioEndAt: Int(600000)},
+		"start equals end": {AudioStartFrom: Int(600000), AudioEndAt: Int(600000)},
+	}
+	for name, opts := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := submitWithOptions(t, opts)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTranscriptWithOptionsRedactPIIWithoutPolicies(t *testing.T) {
+	called := false
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	id, err := client.TranscriptWithOptions("https://some-url.com/some-id", &TranscriptOptions{RedactPII: true})
+	assert.ErrorContains(t, err, "redact_pii_policies")
+	assert.Equal(t, "", id)
+	assert.False(t, called)
+}
+
+func TestTranscriptWithOptionsRedactPIIAudioWithoutRedactPII(t *testing.T) {
+	_, err := submitWithOptions(t, &TranscriptOptions{RedactPIIAudio: true})
+	assert.ErrorContains(t, err, "redact_pii_audio")
+}
+
+func TestTranscriptWithOptionsInvalidRedactPIISub(t *testing.T) {
+	_, err := submitWithOptions(t, &TranscriptOptions{RedactPIISub: PIISubHash})
+	assert.ErrorContains(t, err, "redact_pii_sub requires redact_pii")
+
+	_, err = submitWithOptions(t, &TranscriptOptions{RedactPII: true, RedactPIIPolicies: []PIIPolicy{PIIPersonName}, RedactPIISub: "asterisk"})
+	assert.ErrorContains(t, err, "unsupported redact_pii_sub")
+}
+
+func TestTranscriptWithOptionsSummarizationValidation(t *testing.T) {
+	testCases := []struct {
+		name  string
+		opts  *TranscriptOptions
+		valid bool
+	}{
+		{"defaults", &TranscriptOptions{Summarization: true}, true},
+		{"conversational with speaker labels", &TranscriptOptions{Summarization: true, SummaryModel: SummaryModelConversational, SpeakerLabels: true}, true},
+		{"conversational with dual channel", &TranscriptOptions{Summarization: true, SummaryModel: SummaryModelConversational, DualChannel: true}, true},
+		{"catchy gist", &TranscriptOptions{Summarization: true, SummaryModel: SummaryModelCatchy, SummaryType: SummaryTypeGist}, true},
+		{"conversational without speakers", &TranscriptOptions{Summarization: true, SummaryModel: SummaryModelConversational}, false},
+		{"catchy bullets", &TranscriptOptions{Summarization: true, SummaryModel: SummaryModelCatchy, SummaryType: SummaryTypeBullets}, false},
+		{"informative gist", &TranscriptOptions{Summarization: true, SummaryModel: SummaryModelInformative, SummaryType: SummaryTypeGist}, false},
+		{"unknown model", &TranscriptOptions{Summarization: true, SummaryModel: "poetic"}, false},
+		{"model without summarization", &TranscriptOptions{SummaryModel: SummaryModelInformative}, false},
+		{"with auto chapters", &TranscriptOptions{Summarization: true, AutoChapters: true}, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := submitWithOptions(t, testCase.opts)
+			if testCase.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTranscriptWithOptionsInvalidCustomSpelling(t *testing.T) {
+	testCases := map[string]SpellingRule{
+		"no from":         {To: "Kubernetes"},
+		"empty from":      {From: []string{""}, To: "Kubernetes"},
+		"whitespace from": {From: []string{"kubernetes", "  "}, To: "Kubernetes"},
+		"empty to":        {From: []string{"kubernetes"}},
+		"multi word to":   {From: []string{"kubernetes"}, To: "Kuber Netes"},
+		"whitespace to":   {From: []string{"kubernetes"}, To: " "},
+		"padded to":       {From: []string{"kubernetes"}, To: " Kubernetes"},
+	}
+	for name, rule := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := submitWithOptions(t, &TranscriptOptions{CustomSpelling: []SpellingRule{rule}})
+			assert.ErrorContains(t, err, "custom_spelling")
+		})
+	}
+}
+
+func TestTranscriptWithOptionsInvalidSpeechModel(t *testing.T) {
+	_, err := submitWithOptions(t, &TranscriptOptions{SpeechModel: "turbo"})
+	assert.ErrorContains(t, err, "speech_model")
+}
+
+func TestTranscriptWithOptionsSpeakersExpectedValidation(t *testing.T) {
+	testCases := []struct {
+		name  string
+		opts  *TranscriptOptions
+		error string
+	}{
+		{"speaker labels without hint", &TranscriptOptions{SpeakerLabels: true}, ""},
+		{"minimum", &TranscriptOptions{SpeakerLabels: true, SpeakersExpected: 1}, ""},
+		{"maximum", &TranscriptOptions{SpeakerLabels: true, SpeakersExpected: 10}, ""},
+		{"without speaker labels", &TranscriptOptions{SpeakersExpected: 2}, "requires speaker_labels"},
+		{"too many", &TranscriptOptions{SpeakerLabels: true, SpeakersExpected: 11}, "between 1 and 10"},
+		{"negative", &TranscriptOptions{SpeakerLabels: true, SpeakersExpected: -1}, "between 1 and 10"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := submitWithOptions(t, testCase.opts)
+			if testCase.error == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, testCase.error)
+			}
+		})
+	}
+}
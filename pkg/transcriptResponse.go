@@ -0,0 +1,309 @@
+package assemblyai
+
+import (
+	"errors"
+	"strings"
+)
+
+type TranscriptResponse struct {
+	Id     string              `json:"id"`
+	Status TranscriptionStatus `json:"status"`
+	Text   string              `json:"text"`
+	Error  string              `json:"error"`
+	// AudioDuration is the duration of the audio in seconds, it may be nil until the job is completed
+	AudioDuration *float64 `json:"audio_duration"`
+	// Confidence between 0 and 1 is the overall confidence of the transcript, it is 0 until the job is completed
+	Confidence float64 `json:"confidence"`
+	// LanguageCode is the requested or detected language of the audio
+	LanguageCode string `json:"language_code"`
+	// LanguageConfidence between 0 and 1 is the confidence of the detected language, it is only set if LanguageDetection was requested
+	LanguageConfidence float64 `json:"language_confidence"`
+	// Words contains every transcribed word with its timestamps, it is set once the job is completed
+	Words []Word `json:"words"`
+	// Utterances contains the speaker separated transcript, it is only set if SpeakerLabels was requested
+	Utterances []Utterance `json:"utterances"`
+	// Summary is the summary of the audio, it is only set if Summarization was requested
+	Summary string `json:"summary"`
+	// Chapters contains the chapters of the audio, it is only set if AutoChapters was requested
+	Chapters []Chapter `json:"chapters"`
+	// SentimentAnalysisResults contains the sentiment of each sentence, it is only set if SentimentAnalysis was requested
+	SentimentAnalysisResults []SentimentResult `json:"sentiment_analysis_results"`
+	// Entities contains the detected entities, it is only set if EntityDetection was requested
+	Entities []Entity `json:"entities"`
+	// IABCategoriesResult contains the detected topics, it is only set if IABCategories was requested
+	IABCategoriesResult *TopicResult `json:"iab_categories_result"`
+	// ContentSafetyLabels contains the detected sensitive content, it is only set if ContentSafety was requested
+	ContentSafetyLabels *ContentSafety `json:"content_safety_labels"`
+	// AutoHighlightsResult contains the key phrases, it is only set if AutoHighlights was requested
+	AutoHighlightsResult *AutoHighlights `json:"auto_highlights_result"`
+}
+
+// Word is a single transcribed word, Start and End are in milliseconds.
+type Word struct {
+	Text       string  `json:"text"`
+	Start      int     `json:"start"`
+	End        int     `json:"end"`
+	Confidence float64 `json:"confidence"`
+	Speaker    string  `json:"speaker"`
+	// Channel is the audio channel of the word e.g. "1" or "2", it is only set if DualChannel was requested
+	Channel string `json:"channel"`
+}
+
+// Utterance is an uninterrupted segment of speech of a single speaker, Start and End are in milliseconds.
+type Utterance struct {
+	Speaker    string  `json:"speaker"`
+	Text       string  `json:"text"`
+	Start      int     `json:"start"`
+	End        int     `json:"end"`
+	Confidence float64 `json:"confidence"`
+	Words      []Word  `json:"words"`
+	// Channel is the audio channel of the utterance e.g. "1" or "2", it is only set if DualChannel was requested
+	Channel string `json:"channel"`
+}
+
+// Chapter is a summarized section of the audio, Start and End are in milliseconds.
+type Chapter struct {
+	Summary  string `json:"summary"`
+	Headline string `json:"headline"`
+	Gist     string `json:"gist"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+type Sentiment string
+
+const (
+	Positive Sentiment = "POSITIVE"
+	Negative Sentiment = "NEGATIVE"
+	Neutral  Sentiment = "NEUTRAL"
+)
+
+// IsKnown reports whether the sentiment is one of the documented values, unknown values are kept as returned by AssemblyAI.
+func (sentiment Sentiment) IsKnown() bool {
+	return sentiment == Positive || sentiment == Negative || sentiment == Neutral
+}
+
+// SentimentResult is the sentiment of a sentence, Start and End are in milliseconds.
+type SentimentResult struct {
+	Text       string    `json:"text"`
+	Sentiment  Sentiment `json:"sentiment"`
+	Confidence float64   `json:"confidence"`
+	Start      int       `json:"start"`
+	End        int       `json:"end"`
+	// Speaker is only set if SpeakerLabels was requested
+	Speaker string `json:"speaker"`
+}
+
+// EntityType is the kind of a detected entity https://www.assemblyai.com/docs/audio-intelligence/entity-detection.
+// New types may be added by AssemblyAI, they are kept as returned.
+type EntityType string
+
+const (
+	EntityBankingInformation     EntityType = "banking_information"
+	EntityBloodType              EntityType = "blood_type"
+	EntityCreditCardCVV          EntityType = "credit_card_cvv"
+	EntityCreditCardExpiration   EntityType = "credit_card_expiration"
+	EntityCreditCardNumber       EntityType = "credit_card_number"
+	EntityDate                   EntityType = "date"
+	EntityDateOfBirth            EntityType = "date_of_birth"
+	EntityDriversLicense         EntityType = "drivers_license"
+	EntityDrug                   EntityType = "drug"
+	EntityEmailAddress           EntityType = "email_address"
+	EntityEvent                  EntityType = "event"
+	EntityInjury                 EntityType = "injury"
+	EntityLanguage               EntityType = "language"
+	EntityLocation               EntityType = "location"
+	EntityMedicalCondition       EntityType = "medical_condition"
+	EntityMedicalProcess         EntityType = "medical_process"
+	EntityMoneyAmount            EntityType = "money_amount"
+	EntityNationality            EntityType = "nationality"
+	EntityOccupation             EntityType = "occupation"
+	EntityOrganization           EntityType = "organization"
+	EntityPersonAge              EntityType = "person_age"
+	EntityPersonName             EntityType = "person_name"
+	EntityPhoneNumber            EntityType = "phone_number"
+	EntityPoliticalAffiliation   EntityType = "political_affiliation"
+	EntityReligion               EntityType = "religion"
+	EntityUSSocialSecurityNumber EntityType = "us_social_security_number"
+)
+
+var knownEntityTypes = map[EntityType]bool{
+	EntityBankingInformation: true, EntityBloodType: true, EntityCreditCardCVV: true, EntityCreditCardExpiration: true,
+	EntityCreditCardNumber: true, EntityDate: true, EntityDateOfBirth: true, EntityDriversLicense: true, EntityDrug: true,
+	EntityEmailAddress: true, EntityEvent: true, EntityInjury: true, EntityLanguage: true, EntityLocation: true,
+	EntityMedicalCondition: true, EntityMedicalProcess: true, EntityMoneyAmount: true, EntityNationality: true,
+	EntityOccupation: true, EntityOrganization: true, EntityPersonAge: true, EntityPersonName: true,
+	EntityPhoneNumber: true, EntityPoliticalAffiliation: true, EntityReligion: true, EntityUSSocialSecurityNumber: true,
+}
+
+// IsKnown reports whether the entity type is one of the documented values.
+func (entityType EntityType) IsKnown() bool {
+	return knownEntityTypes[entityType]
+}
+
+// Entity is a detected entity, Start and End are in milliseconds.
+type Entity struct {
+	EntityType EntityType `json:"entity_type"`
+	Text       string     `json:"text"`
+	Start      int        `json:"start"`
+	End        int        `json:"end"`
+}
+
+// Timestamp is a time range of the audio in milliseconds.
+type Timestamp struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// TopicResult contains the topics detected in the audio https://www.assemblyai.com/docs/audio-intelligence/topic-detection.
+type TopicResult struct {
+	Status  string         `json:"status"`
+	Results []TopicSegment `json:"results"`
+	// Summary maps each detected topic label e.g. "Technology>Computing" to its relevance for the whole audio
+	Summary map[string]float64 `json:"summary"`
+}
+
+// TopicSegment contains the topics detected in a segment of the audio.
+type TopicSegment struct {
+	Text      string       `json:"text"`
+	Labels    []TopicLabel `json:"labels"`
+	Timestamp Timestamp    `json:"timestamp"`
+}
+
+type TopicLabel struct {
+	// Label is the taxonomy path of the topic e.g. "Technology>Computing", see SplitTopicLabel
+	Label     string  `json:"label"`
+	Relevance float64 `json:"relevance"`
+}
+
+// SplitTopicLabel splits a topic label like "Technology>Computing" into its path segments.
+func SplitTopicLabel(label string) []string {
+	if label == "" {
+		return nil
+	}
+	return strings.Split(label, ">")
+}
+
+// ContentSafetyLabelName is a kind of sensitive content https://www.assemblyai.com/docs/audio-intelligence/content-moderation.
+// New labels may be added by AssemblyAI, they are kept as returned.
+type ContentSafetyLabelName string
+
+const (
+	ContentSafetyAccidents             ContentSafetyLabelName = "accidents"
+	ContentSafetyAlcohol               ContentSafetyLabelName = "alcohol"
+	ContentSafetyFinancials            ContentSafetyLabelName = "financials"
+	ContentSafetyCrimeViolence         ContentSafetyLabelName = "crime_violence"
+	ContentSafetyDrugs                 ContentSafetyLabelName = "drugs"
+	ContentSafetyGambling              ContentSafetyLabelName = "gambling"
+	ContentSafetyHateSpeech            ContentSafetyLabelName = "hate_speech"
+	ContentSafetyHealthIssues          ContentSafetyLabelName = "health_issues"
+	ContentSafetyManga                 ContentSafetyLabelName = "manga"
+	ContentSafetyMarijuana             ContentSafetyLabelName = "marijuana"
+	ContentSafetyDisasters             ContentSafetyLabelName = "disasters"
+	ContentSafetyNegativeNews          ContentSafetyLabelName = "negative_news"
+	ContentSafetyNSFW                  ContentSafetyLabelName = "nsfw"
+	ContentSafetyPornography           ContentSafetyLabelName = "pornography"
+	ContentSafetyProfanity             ContentSafetyLabelName = "profanity"
+	ContentSafetySensitiveSocialIssues ContentSafetyLabelName = "sensitive_social_issues"
+	ContentSafetyTerrorism             ContentSafetyLabelName = "terrorism"
+	ContentSafetyTobacco               ContentSafetyLabelName = "tobacco"
+	ContentSafetyWeapons               ContentSafetyLabelName = "weapons"
+)
+
+// ContentSafety contains the sensitive content detected in the audio.
+type ContentSafety struct {
+	Status  string                 `json:"status"`
+	Results []ContentSafetySegment `json:"results"`
+	// Summary maps each detected label to the confidence that it applies to the whole audio
+	Summary map[ContentSafetyLabelName]float64 `json:"summary"`
+	// SeverityScoreSummary maps each detected label to the distribution of its severity over the whole audio
+	SeverityScoreSummary map[ContentSafetyLabelName]SeverityScore `json:"severity_score_summary"`
+}
+
+// ContentSafetySegment contains the sensitive content detected in a segment of the audio.
+type ContentSafetySegment struct {
+	Text              string               `json:"text"`
+	Labels            []ContentSafetyLabel `json:"labels"`
+	SentencesIdxStart int                  `json:"sentences_idx_start"`
+	SentencesIdxEnd   int                  `json:"sentences_idx_end"`
+	Timestamp         Timestamp            `json:"timestamp"`
+}
+
+type ContentSafetyLabel struct {
+	Label      ContentSafetyLabelName `json:"label"`
+	Confidence float64                `json:"confidence"`
+	// Severity between 0 and 1 describes how severe the content is
+	Severity float64 `json:"severity"`
+}
+
+type SeverityScore struct {
+	Low    float64 `json:"low"`
+	Medium float64 `json:"medium"`
+	High   float64 `json:"high"`
+}
+
+// AutoHighlights contains the key phrases detected in the audio.
+type AutoHighlights struct {
+	Status  string      `json:"status"`
+	Results []Highlight `json:"results"`
+}
+
+// Highlight is a key phrase of the audio with every time range it is spoken at.
+type Highlight struct {
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+	// Rank between 0 and 1 describes the relevance of the phrase
+	Rank       float64     `json:"rank"`
+	Timestamps []Timestamp `json:"timestamps"`
+}
+
+// LanguageConfidenceError is returned if the confidence of the detected language is below the requested LanguageConfidenceThreshold.
+type LanguageConfidenceError struct {
+	Message            string
+	LanguageCode       string
+	LanguageConfidence float64
+}
+
+func (e *LanguageConfidenceError) Error() string {
+	return e.Message
+}
+
+// Is lets errors.Is match the LanguageConfidenceError with ErrTranscriptFailed.
+func (e *LanguageConfidenceError) Is(target error) bool {
+	return target == ErrTranscriptFailed
+}
+
+// ErrTranscriptFailed is matched by the error of every transcription job with status error, the message of the error is the one of AssemblyAI.
+var ErrTranscriptFailed = errors.New("transcription failed")
+
+// transcriptFailedError is a failed transcription job matching ErrTranscriptFailed and the reason of the failure if it is known.
+type transcriptFailedError struct {
+	message string
+	reason  error
+}
+
+func (e *transcriptFailedError) Error() string {
+	return e.message
+}
+
+func (e *transcriptFailedError) Is(target error) bool {
+	return target == ErrTranscriptFailed || (e.reason != nil && target == e.reason)
+}
+
+// ErrSpeechThresholdNotMet is returned if the share of speech in the audio is below the requested SpeechThreshold.
+var ErrSpeechThresholdNotMet = errors.New("speech threshold not met")
+
+// err converts the error of a failed transcription job into a go error.
+func (data *TranscriptResponse) err() error {
+	if strings.Contains(data.Error, "below the requested confidence threshold") {
+		return &LanguageConfidenceError{
+			Message:            data.Error,
+			LanguageCode:       data.LanguageCode,
+			LanguageConfidence: data.LanguageConfidence,
+		}
+	}
+	if strings.Contains(strings.ToLower(data.Error), "speech threshold") {
+		return &transcriptFailedError{message: data.Error, reason: ErrSpeechThresholdNotMet}
+	}
+	return &transcriptFailedError{message: data.Error}
+}
@@ -0,0 +1,809 @@
+package assemblyai
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// getFixtureServer returns a fake server responding with the content of the given testdata fixture.
+func getFixtureServer(t *testing.T, fixture string) *httptest.Server {
+	content, err := os.ReadFile("testdata/" + fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write(content)
+	})
+}
+
+func TestTranscriptResponseUtterances(t *testing.T) {
+	server := getFixtureServer(t, "transcript_speaker_labels.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.PollTranscriptFull("6rlr37h5zf-b3d4-4b5a-9ba1-1a0e1d6f3e2a", nil)
+	assert.NoError(t, err)
+	assert.Len(t, data.Utterances, 2)
+
+	first := data.Utterances[0]
+	assert.Equal(t, "A", first.Speaker)
+	assert.Equal(t, "Hello, how are you?", first.Text)
+	assert.Equal(t, 250, first.Start)
+	assert.Equal(t, 1650, first.End)
+	assert.Equal(t, 0.97, first.Confidence)
+	assert.Len(t, first.Words, 4)
+	assert.Equal(t, Word{Text: "you?", Start: 1300, End: 1650, Confidence: 0.96, Speaker: "A"}, first.Words[3])
+
+	second := data.Utterances[1]
+	assert.Equal(t, "B", second.Speaker)
+	assert.Equal(t, "I am fine, thanks.", second.Text)
+	assert.Equal(t, 2100, second.Start)
+	assert.Equal(t, 3400, second.End)
+	for _, word := range second.Words {
+		assert.Equal(t, "B", word.Speaker)
+	}
+}
+
+func TestTranscriptResponseWithoutUtterances(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "completed",
+			"text": "Hello",
+			"utterances": null
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.PollTranscriptFull("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, data.Utterances)
+}
+
+func TestTranscriptResponseLanguageDetection(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "completed",
+			"text": "Guten Tag",
+			"language_code": "de",
+			"language_confidence": 0.9821
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.PollTranscriptFull("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "de", data.LanguageCode)
+	assert.Equal(t, 0.9821, data.LanguageConfidence)
+}
+
+func TestPollTranscriptLanguageConfidenceError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "error",
+			"language_code": "fr",
+			"language_confidence": 0.2134,
+			"error": "detected language 'fr', confidence 0.2134, is below the requested confidence threshold value of '0.8'"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	var confidenceError *LanguageConfidenceError
+	assert.ErrorAs(t, err, &confidenceError)
+	assert.Equal(t, "fr", confidenceError.LanguageCode)
+	assert.Equal(t, 0.2134, confidenceError.LanguageConfidence)
+	assert.ErrorIs(t, err, ErrTranscriptFailed)
+	assert.Equal(t, "", text)
+}
+
+func TestPollTranscriptSpeechThresholdError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "error",
+			"error": "Audio speech threshold 0.5 is higher than the detected speech ratio 0.1."
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	text, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.ErrorIs(t, err, ErrSpeechThresholdNotMet)
+	assert.ErrorIs(t, err, ErrTranscriptFailed)
+	assert.EqualError(t, err, "Audio speech threshold 0.5 is higher than the detected speech ratio 0.1.")
+	assert.Equal(t, "", text)
+}
+
+func TestPollTranscriptOtherError(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "error", "error": "Download error"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.PollTranscript("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.EqualError(t, err, "Download error")
+	assert.ErrorIs(t, err, ErrTranscriptFailed)
+	assert.NotErrorIs(t, err, ErrSpeechThresholdNotMet)
+}
+
+func TestGetUtterances(t *testing.T) {
+	server := getFixtureServer(t, "transcript_speaker_labels.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	utterances, err := client.GetUtterances("6rlr37h5zf-b3d4-4b5a-9ba1-1a0e1d6f3e2a")
+	assert.NoError(t, err)
+	assert.Len(t, utterances, 2)
+	assert.Equal(t, "A", utterances[0].Speaker)
+	assert.Equal(t, "B", utterances[1].Speaker)
+}
+
+func TestGetUtterancesWithoutSpeakerLabels(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "completed",
+			"text": "Hello",
+			"utterances": null
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	utterances, err := client.GetUtterances("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.NoError(t, err)
+	assert.Nil(t, utterances)
+}
+
+func TestGetUtterancesNotCompleted(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "processing"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	utterances, err := client.GetUtterances("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.ErrorIs(t, err, ErrTranscriptNotCompleted)
+	assert.Nil(t, utterances)
+}
+
+func TestTranscriptResponseWords(t *testing.T) {
+	server := getFixtureServer(t, "transcript_speaker_labels.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.PollTranscriptFull("6rlr37h5zf-b3d4-4b5a-9ba1-1a0e1d6f3e2a", nil)
+	assert.NoError(t, err)
+	assert.Len(t, data.Words, 8)
+	assert.Equal(t, Word{Text: "Hello,", Start: 250, End: 650, Confidence: 0.99, Speaker: "A"}, data.Words[0])
+	assert.Equal(t, Word{Text: "thanks.", Start: 2950, End: 3400, Confidence: 0.95, Speaker: "B"}, data.Words[7])
+}
+
+func TestTranscriptResponseManyWords(t *testing.T) {
+	server := getFixtureServer(t, "transcript_words.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90")
+	assert.NoError(t, err)
+	assert.Len(t, data.Words, 397)
+	assert.Equal(t, Word{Text: "Good", Start: 320, End: 605, Confidence: 0.9792, Speaker: "A"}, data.Words[0])
+	assert.Equal(t, Word{Text: "it.", Start: 164271, End: 164633, Confidence: 0.6893, Speaker: "A"}, data.Words[396])
+
+	texts := make([]string, len(data.Words))
+	for i, word := range data.Words {
+		texts[i] = word.Text
+		assert.Less(t, word.Start, word.End)
+		assert.Greater(t, word.Confidence, 0.0)
+		assert.LessOrEqual(t, word.Confidence, 1.0)
+		assert.Contains(t, []string{"A", "B"}, word.Speaker)
+		if i > 0 {
+			assert.LessOrEqual(t, data.Words[i-1].End, word.Start)
+		}
+	}
+	assert.Equal(t, data.Text, strings.Join(texts, " "))
+}
+
+func TestTranscriptResponseAudioDurationAndConfidence(t *testing.T) {
+	server := getFixtureServer(t, "transcript_words.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90")
+	assert.NoError(t, err)
+	assert.NotNil(t, data.AudioDuration)
+	assert.Equal(t, 165.0, *data.AudioDuration)
+	assert.Equal(t, 0.93, data.Confidence)
+
+	data, err = client.PollTranscriptFull("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 165.0, *data.AudioDuration)
+	assert.Equal(t, 0.93, data.Confidence)
+}
+
+func TestTranscriptResponseAudioDurationQueued(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "queued",
+			"audio_duration": null,
+			"confidence": null
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.NoError(t, err)
+	assert.Nil(t, data.AudioDuration)
+	assert.Equal(t, 0.0, data.Confidence)
+}
+
+func TestGetWords(t *testing.T) {
+	server := getFixtureServer(t, "transcript_speaker_labels.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	words, err := client.GetWords("6rlr37h5zf-b3d4-4b5a-9ba1-1a0e1d6f3e2a")
+	assert.NoError(t, err)
+	assert.Len(t, words, 8)
+	for i := 1; i < len(words); i++ {
+		assert.LessOrEqual(t, words[i-1].End, words[i].Start)
+	}
+}
+
+func TestGetWordsNotCompleted(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "queued",
+			"words": null
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	words, err := client.GetWords("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.ErrorIs(t, err, ErrTranscriptNotCompleted)
+	assert.Nil(t, words)
+}
+
+func TestTranscriptResponseDualChannel(t *testing.T) {
+	server := getFixtureServer(t, "transcript_dual_channel.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.PollTranscriptFull("9ea3f3ae-8b7a-4e6f-a1b8-3b3e5e3a9c01", nil)
+	assert.NoError(t, err)
+
+	channels := map[string][]string{}
+	for _, word := range data.Words {
+		channels[word.Channel] = append(channels[word.Channel], word.Text)
+	}
+	assert.Equal(t, map[string][]string{
+		"1": {"Thanks", "for", "calling."},
+		"2": {"Hi,", "my", "order", "is", "late."},
+	}, channels)
+
+	assert.Len(t, data.Utterances, 2)
+	assert.Equal(t, "1", data.Utterances[0].Channel)
+	assert.Equal(t, "Thanks for calling.", data.Utterances[0].Text)
+	assert.Equal(t, "2", data.Utterances[1].Channel)
+	assert.Equal(t, "Hi, my order is late.", data.Utterances[1].Text)
+	for _, utterance := range data.Utterances {
+		for _, word := range utterance.Words {
+			assert.Equal(t, utterance.Channel, word.Channel)
+		}
+	}
+}
+
+func TestGetUtterancesDualChannelWithoutSpeakerLabels(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "9ea3f3ae-8b7a-4e6f-a1b8-3b3e5e3a9c01",
+			"status": "completed",
+			"dual_channel": true,
+			"speaker_labels": false,
+			"text": "Hello. Hi.",
+			"words": [
+				{"text": "Hello.", "start": 100, "end": 500, "confidence": 0.98, "speaker": null, "channel": "1"},
+				{"text": "Hi.", "start": 700, "end": 900, "confidence": 0.97, "speaker": null, "channel": "2"}
+			],
+			"utterances": [
+				{"speaker": null, "channel": "1", "text": "Hello.", "start": 100, "end": 500, "confidence": 0.98, "words": []},
+				{"speaker": null, "channel": "2", "text": "Hi.", "start": 700, "end": 900, "confidence": 0.97, "words": []}
+			]
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	utterances, err := client.GetUtterances("9ea3f3ae-8b7a-4e6f-a1b8-3b3e5e3a9c01")
+	assert.NoError(t, err)
+	assert.Len(t, utterances, 2)
+	assert.Equal(t, "1", utterances[0].Channel)
+	assert.Equal(t, "", utterances[0].Speaker)
+	assert.Equal(t, "2", utterances[1].Channel)
+	assert.Equal(t, "Hi.", utterances[1].Text)
+
+	words, err := client.GetWords("9ea3f3ae-8b7a-4e6f-a1b8-3b3e5e3a9c01")
+	assert.NoError(t, err)
+	assert.Equal(t, Word{Text: "Hi.", Start: 700, End: 900, Confidence: 0.97, Channel: "2"}, words[1])
+}
+
+func TestTranscriptResponseChapters(t *testing.T) {
+	server := getFixtureServer(t, "transcript_chapters.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("b2c7f1d0-54a3-4c8e-9f1e-7d5b0c3a2e11")
+	assert.NoError(t, err)
+	assert.Len(t, data.Chapters, 3)
+	assert.Equal(t, Chapter{
+		Summary:  "Smoke from hundreds of wildfires in Canada is triggering air quality alerts throughout the US.",
+		Headline: "Wildfire smoke triggers air quality alerts",
+		Gist:     "Wildfire smoke",
+		Start:    29610,
+		End:      1425340,
+	}, data.Chapters[1])
+	assert.Equal(t, 250, data.Chapters[0].Start)
+	assert.Equal(t, 1431890, data.Chapters[2].End)
+}
+
+func TestTranscriptResponseSentimentAnalysis(t *testing.T) {
+	server := getFixtureServer(t, "transcript_sentiment_analysis.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("c4f0e9b2-7a1d-4e55-8b0e-2f9d6a4c1b33")
+	assert.NoError(t, err)
+	assert.Len(t, data.SentimentAnalysisResults, 4)
+	assert.Equal(t, SentimentResult{
+		Text:       "Thanks for the quick help.",
+		Sentiment:  Positive,
+		Confidence: 0.9243,
+		Start:      250,
+		End:        1810,
+		Speaker:    "A",
+	}, data.SentimentAnalysisResults[0])
+	assert.Equal(t, Negative, data.SentimentAnalysisResults[1].Sentiment)
+	assert.Equal(t, Neutral, data.SentimentAnalysisResults[2].Sentiment)
+	assert.Equal(t, "B", data.SentimentAnalysisResults[2].Speaker)
+
+	unknown := data.SentimentAnalysisResults[3].Sentiment
+	assert.Equal(t, Sentiment("MIXED"), unknown)
+	assert.False(t, unknown.IsKnown())
+	assert.True(t, Positive.IsKnown())
+}
+
+func TestTranscriptResponseEntities(t *testing.T) {
+	server := getFixtureServer(t, "transcript_entities.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("d81e2b6c-0f4a-4a7e-b3c2-5e9f8a7b6c44")
+	assert.NoError(t, err)
+	assert.Equal(t, []Entity{
+		{EntityType: EntityPersonName, Text: "Jane Doe", Start: 1200, End: 1850},
+		{EntityType: EntityOrganization, Text: "Acme Corp", Start: 1900, End: 2600},
+		{EntityType: EntityLocation, Text: "Berlin", Start: 2700, End: 3150},
+		{EntityType: EntityPhoneNumber, Text: "555-0100", Start: 3900, End: 5200},
+		{EntityType: "spaceship_name", Text: "Enterprise", Start: 5400, End: 6000},
+	}, data.Entities)
+	assert.True(t, data.Entities[0].EntityType.IsKnown())
+	assert.False(t, data.Entities[4].EntityType.IsKnown())
+}
+
+func TestTranscriptResponseIABCategories(t *testing.T) {
+	server := getFixtureServer(t, "transcript_iab_categories.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("e5a9c7d3-1b2f-4c6e-8d0a-3f4e5d6c7b55")
+	assert.NoError(t, err)
+	topics := data.IABCategoriesResult
+	assert.Equal(t, "success", topics.Status)
+	assert.Len(t, topics.Results, 2)
+	assert.Equal(t, TopicSegment{
+		Text: "Apple released a new laptop.",
+		Labels: []TopicLabel{
+			{Label: "Technology&Computing>ConsumerElectronics>Laptops", Relevance: 0.9311},
+			{Label: "BusinessAndFinance>Business>ProductLaunch", Relevance: 0.0524},
+		},
+		Timestamp: Timestamp{Start: 250, End: 2100},
+	}, topics.Results[0])
+	assert.Equal(t, map[string]float64{
+		"Technology&Computing>ConsumerElectronics>Laptops": 1.0,
+		"Technology&Computing>Computing>ComputerHardware":  0.9461,
+		"BusinessAndFinance>Business>ProductLaunch":        0.0563,
+	}, topics.Summary)
+}
+
+func TestTranscriptResponseWithoutIABCategories(t *testing.T) {
+	server := getFixtureServer(t, "transcript_chapters.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("b2c7f1d0-54a3-4c8e-9f1e-7d5b0c3a2e11")
+	assert.NoError(t, err)
+	assert.Nil(t, data.IABCategoriesResult)
+}
+
+func TestSplitTopicLabel(t *testing.T) {
+	assert.Equal(t, []string{"Technology&Computing", "ConsumerElectronics", "Laptops"}, SplitTopicLabel("Technology&Computing>ConsumerElectronics>Laptops"))
+	assert.Equal(t, []string{"Sports"}, SplitTopicLabel("Sports"))
+	assert.Nil(t, SplitTopicLabel(""))
+}
+
+func TestTranscriptResponseContentSafety(t *testing.T) {
+	server := getFixtureServer(t, "transcript_content_safety.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("f6b0d8e4-2c3a-4d7f-9e1b-4a5f6e7d8c66")
+	assert.NoError(t, err)
+	contentSafety := data.ContentSafetyLabels
+	assert.Equal(t, "success", contentSafety.Status)
+	assert.Len(t, contentSafety.Results, 2)
+	assert.Equal(t, ContentSafetySegment{
+		Text: "Police arrested the armed suspects.",
+		Labels: []ContentSafetyLabel{
+			{Label: ContentSafetyCrimeViolence, Confidence: 0.9211, Severity: 0.7133},
+			{Label: ContentSafetyWeapons, Confidence: 0.6512, Severity: 0.1021},
+			{Label: "cyber_threats", Confidence: 0.1204, Severity: 0.05},
+		},
+		SentencesIdxStart: 1,
+		SentencesIdxEnd:   2,
+		Timestamp:         Timestamp{Start: 2500, End: 4800},
+	}, contentSafety.Results[1])
+	assert.Equal(t, map[ContentSafetyLabelName]float64{
+		ContentSafetyDisasters:     0.8142,
+		ContentSafetyCrimeViolence: 0.9211,
+		ContentSafetyWeapons:       0.6512,
+	}, contentSafety.Summary)
+	assert.Equal(t, SeverityScore{Low: 0.0, Medium: 0.3, High: 0.7}, contentSafety.SeverityScoreSummary[ContentSafetyCrimeViolence])
+	assert.Len(t, contentSafety.SeverityScoreSummary, 3)
+}
+
+func TestTranscriptResponseAutoHighlights(t *testing.T) {
+	server := getFixtureServer(t, "transcript_auto_highlights.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("a1c3e5f7-9b2d-4f6a-8c0e-5b6a7f8e9d77")
+	assert.NoError(t, err)
+	assert.Equal(t, &AutoHighlights{
+		Status: "success",
+		Results: []Highlight{
+			{
+				Text:       "air quality alerts",
+				Count:      2,
+				Rank:       0.08,
+				Timestamps: []Timestamp{{Start: 3978, End: 5114}, {Start: 6210, End: 7402}},
+			},
+			{
+				Text:       "wildfires",
+				Count:      1,
+				Rank:       0.06,
+				Timestamps: []Timestamp{{Start: 1250, End: 1930}},
+			},
+		},
+	}, data.AutoHighlightsResult)
+}
+
+func TestTranscriptResponseWithoutAutoHighlights(t *testing.T) {
+	server := getFixtureServer(t, "transcript_chapters.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.GetTranscript("b2c7f1d0-54a3-4c8e-9f1e-7d5b0c3a2e11")
+	assert.NoError(t, err)
+	assert.Nil(t, data.AutoHighlightsResult)
+}
+
+func TestTranscriptResponseSummary(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "completed",
+			"text": "Smoke from hundreds of wildfires in Canada is triggering air quality alerts.",
+			"summarization": true,
+			"summary_model": "informative",
+			"summary_type": "bullets",
+			"summary": "- Smoke from wildfires in Canada triggers air quality alerts.\n- The alerts last until Friday."
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	data, err := client.PollTranscriptFull("5551722-f677-48a6-9287-39c0aafd9ac1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "- Smoke from wildfires in Canada triggers air quality alerts.\n- The alerts last until Friday.", data.Summary)
+}
+
+func TestGetSummary(t *testing.T) {
+	server := getFixtureServer(t, "transcript_summarization.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	summary, err := client.GetSummary("2b1f7c8e-4d3a-4a8e-9f61-7c2e5d0b9a14")
+	assert.NoError(t, err)
+	assert.Equal(t, "- Wildfire smoke from Canada is affecting air quality in the US.\n- The host thanks the listeners.", summary)
+}
+
+func TestGetSummaryWithoutSummarization(t *testing.T) {
+	server := getFixtureServer(t, "transcript_speaker_labels.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	summary, err := client.GetSummary("6rlr37h5zf-b3d4-4b5a-9ba1-1a0e1d6f3e2a")
+	assert.NoError(t, err)
+	assert.Empty(t, summary)
+}
+
+func TestGetSummaryNotCompleted(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "processing"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	summary, err := client.GetSummary("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.ErrorIs(t, err, ErrTranscriptNotCompleted)
+	assert.Empty(t, summary)
+}
+
+func TestGetChapters(t *testing.T) {
+	server := getFixtureServer(t, "transcript_chapters.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	chapters, err := client.GetChapters("b2c7f1d0-54a3-4c8e-9f1e-7d5b0c3a2e11")
+	assert.NoError(t, err)
+	assert.Len(t, chapters, 3)
+	assert.Equal(t, "Wildfire smoke triggers air quality alerts", chapters[1].Headline)
+	for i := 1; i < len(chapters); i++ {
+		assert.LessOrEqual(t, chapters[i-1].End, chapters[i].Start)
+	}
+}
+
+func TestGetChaptersNotRequested(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "completed",
+			"auto_chapters": false,
+			"chapters": null
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	chapters, err := client.GetChapters("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.NoError(t, err)
+	assert.Nil(t, chapters)
+}
+
+func TestGetSentiment(t *testing.T) {
+	server := getFixtureServer(t, "transcript_sentiment_analysis.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	results, err := client.GetSentiment("c4f0e9b2-7a1d-4e55-8b0e-2f9d6a4c1b33")
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+
+	negative := []string{}
+	for _, result := range results {
+		if result.Sentiment == Negative {
+			negative = append(negative, result.Speaker)
+		}
+	}
+	assert.Equal(t, []string{"A"}, negative)
+}
+
+func TestGetSentimentNotCompleted(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "queued"
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	results, err := client.GetSentiment("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.ErrorIs(t, err, ErrTranscriptNotCompleted)
+	assert.Nil(t, results)
+}
+
+func TestGetEntities(t *testing.T) {
+	server := getFixtureServer(t, "transcript_entities.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	entities, err := client.GetEntities("d81e2b6c-0f4a-4a7e-b3c2-5e9f8a7b6c44")
+	assert.NoError(t, err)
+	assert.Len(t, entities, 5)
+
+	tags := map[EntityType][]string{}
+	for _, entity := range entities {
+		if entity.EntityType.IsKnown() {
+			tags[entity.EntityType] = append(tags[entity.EntityType], entity.Text)
+		}
+	}
+	assert.Equal(t, map[EntityType][]string{
+		EntityPersonName:   {"Jane Doe"},
+		EntityOrganization: {"Acme Corp"},
+		EntityLocation:     {"Berlin"},
+		EntityPhoneNumber:  {"555-0100"},
+	}, tags)
+}
+
+func TestGetEntitiesNotRequested(t *testing.T) {
+	server := getFixtureServer(t, "transcript_speaker_labels.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	entities, err := client.GetEntities("6rlr37h5zf-b3d4-4b5a-9ba1-1a0e1d6f3e2a")
+	assert.NoError(t, err)
+	assert.Nil(t, entities)
+}
+
+func TestGetContentSafety(t *testing.T) {
+	server := getFixtureServer(t, "transcript_content_safety.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	contentSafety, err := client.GetContentSafety("f6b0d8e4-2c3a-4d7f-9e1b-4a5f6e7d8c66")
+	assert.NoError(t, err)
+	assert.Equal(t, "success", contentSafety.Status)
+	assert.Len(t, contentSafety.Results, 2)
+	assert.Equal(t, 0.9211, contentSafety.Summary["crime_violence"])
+	assert.Equal(t, SeverityScore{Low: 0, Medium: 0.3, High: 0.7}, contentSafety.SeverityScoreSummary["crime_violence"])
+
+	flagged := []ContentSafetyLabelName{}
+	for _, segment := range contentSafety.Results {
+		for _, label := range segment.Labels {
+			if label.Confidence > 0.5 && label.Severity > 0.5 {
+				flagged = append(flagged, label.Label)
+			}
+		}
+	}
+	assert.Equal(t, []ContentSafetyLabelName{"crime_violence"}, flagged)
+}
+
+func TestGetContentSafetyNotRequested(t *testing.T) {
+	server := getFixtureServer(t, "transcript_speaker_labels.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	contentSafety, err := client.GetContentSafety("6rlr37h5zf-b3d4-4b5a-9ba1-1a0e1d6f3e2a")
+	assert.NoError(t, err)
+	assert.Nil(t, contentSafety)
+}
+
+func TestGetTopics(t *testing.T) {
+	server := getFixtureServer(t, "transcript_iab_categories.json")
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	topics, err := client.GetTopics("e5a9c7d3-1b2f-4c6e-8d0a-3f4e5d6c7b55")
+	assert.NoError(t, err)
+	assert.Equal(t, "success", topics.Status)
+	assert.Len(t, topics.Results, 2)
+	assert.Equal(t, 0.8812, topics.Results[1].Labels[0].Relevance)
+
+	ranked := []string{}
+	for label := range topics.Summary {
+		ranked = append(ranked, label)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		return topics.Summary[ranked[i]] > topics.Summary[ranked[j]]
+	})
+	assert.Equal(t, []string{
+		"Technology&Computing>ConsumerElectronics>Laptops",
+		"Technology&Computing>Computing>ComputerHardware",
+		"BusinessAndFinance>Business>ProductLaunch",
+	}, ranked)
+}
+
+func TestGetTopicsNotCompleted(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write([]byte(`{
+			"id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+			"status": "processing",
+			"iab_categories_result": null
+		}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	topics, err := client.GetTopics("5551722-f677-48a6-9287-39c0aafd9ac1")
+	assert.ErrorIs(t, err, ErrTranscriptNotCompleted)
+	assert.Nil(t, topics)
+}
+
+func TestPollTranscriptFullKeepsOptionalFields(t *testing.T) {
+	fixtures := []string{
+		"transcript_auto_highlights.json",
+		"transcript_chapters.json",
+		"transcript_content_safety.json",
+		"transcript_dual_channel.json",
+		"transcript_entities.json",
+		"transcript_iab_categories.json",
+		"transcript_sentiment_analysis.json",
+		"transcript_speaker_labels.json",
+		"transcript_summarization.json",
+		"transcript_words.json",
+	}
+	for _, fixture := range fixtures {
+		t.Run(fixture, func(t *testing.T) {
+			content, err := os.ReadFile("testdata/" + fixture)
+			assert.NoError(t, err)
+			var expected TranscriptResponse
+			assert.NoError(t, json.Unmarshal(content, &expected))
+			assert.Equal(t, Completed, expected.Status)
+
+			statuses := []string{"queued", "processing"}
+			requests := 0
+			server := getServer(func(res http.ResponseWriter, req *http.Request) {
+				res.WriteHeader(200)
+				if requests < len(statuses) {
+					res.Write([]byte(`{"id": "` + expected.Id + `", "status": "` + statuses[requests] + `"}`))
+				} else {
+					res.Write(content)
+				}
+				requests++
+			})
+			defer server.Close()
+			client := New(server.URL, "some-token", http.DefaultClient)
+
+			data, err := client.PollTranscriptFull(expected.Id, &PollSettings{Frequency: time.Millisecond})
+			assert.NoError(t, err)
+			assert.Equal(t, 3, requests)
+			assert.Equal(t, &expected, data)
+
+			text, err := client.PollTranscript(expected.Id, &PollSettings{Frequency: time.Millisecond})
+			assert.NoError(t, err)
+			assert.Equal(t, expected.Text, text)
+		})
+	}
+}
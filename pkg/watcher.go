@@ -0,0 +1,167 @@
+package assemblyai
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WatchResult is the outcome of a transcription job watched by a Watcher, either Transcript or Err is set.
+type WatchResult struct {
+	Id         string
+	Transcript *TranscriptResponse
+	Err        error
+}
+
+// Watcher polls many transcription jobs with a shared ticker and a bounded number of concurrent requests,
+// instead of running a poll loop per job. Jobs are added and removed while the watcher is running.
+type Watcher struct {
+	client      AssemblyAI
+	frequency   time.Duration
+	concurrency int
+	results     chan WatchResult
+
+	mu  sync.Mutex
+	ids []string
+}
+
+// NewWatcher returns a watcher polling every job once per frequency with at most concurrency requests at the same time.
+// A zero frequency defaults to 5 seconds and a concurrency below 1 polls one job at a time
+func NewWatcher(client AssemblyAI, frequency time.Duration, concurrency int) *Watcher {
+	if frequency <= 0 {
+		frequency = defaultPollFrequency
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Watcher{
+		client:      client,
+		frequency:   frequency,
+		concurrency: concurrency,
+		results:     make(chan WatchResult, concurrency),
+	}
+}
+
+// Add watches the job with the given id until it is completed or failed.
+// Returns false if the job is already watched
+func (w *Watcher) Add(id string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.indexOf(id) >= 0 {
+		return false
+	}
+	w.ids = append(w.ids, id)
+	return true
+}
+
+// Remove stops watching the job with the given id, a poll of the job which is in flight is discarded.
+// Returns false if the job is not watched
+func (w *Watcher) Remove(id string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	i := w.indexOf(id)
+	if i < 0 {
+		return false
+	}
+	w.ids = append(w.ids[:i], w.ids[i+1:]...)
+	return true
+}
+
+// Len returns the number of watched jobs.
+func (w *Watcher) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.ids)
+}
+
+// Results returns the channel receiving a single result for every job once it is completed, failed or could not be fetched.
+// Rate limited and unavailable responses (429, 502 and 503) are not results, the job stays watched and is polled again on the next tick.
+// The channel is closed once Run returns
+func (w *Watcher) Results() <-chan WatchResult {
+	return w.results
+}
+
+// Polls the watched jobs until ctx is done, it must only be called once.
+// Every tick polls all watched jobs, a job is no longer watched once its result is sent to Results.
+// A result which can not be sent before ctx is done is dropped
+// Returns ctx.Err() after the requests in flight are finished and Results is closed
+func (w *Watcher) Run(ctx context.Context) error {
+	defer close(w.results)
+	ticker := time.NewTicker(w.frequency)
+	defer ticker.Stop()
+	for {
+		w.poll(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll fetches every watched job once with at most concurrency requests at the same time.
+func (w *Watcher) poll(ctx context.Context) {
+	w.mu.Lock()
+	ids := append([]string{}, w.ids...)
+	w.mu.Unlock()
+
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+	for worker := 0; worker < w.concurrency && worker < len(ids); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				w.pollJob(ctx, id)
+			}
+		}()
+	}
+	defer wg.Wait()
+	defer close(jobs)
+	for _, id := range ids {
+		select {
+		case <-ctx.Done():
+			return
+		case jobs <- id:
+		}
+	}
+}
+
+// pollJob fetches the job once and sends its result if it is done and still watched.
+func (w *Watcher) pollJob(ctx context.Context, id string) {
+	data, err := w.client.GetTranscriptContext(ctx, id)
+	result := WatchResult{Id: id}
+	switch {
+	case err != nil && ctx.Err() != nil:
+		// the request was cancelled by Run returning, the job is neither done nor failed
+		return
+	case err != nil:
+		if _, retryable := isRetryablePollError(err); retryable {
+			return
+		}
+		result.Err = err
+	case data.Status == Err:
+		result.Err = data.err()
+	case data.Status == Completed:
+		result.Transcript = data
+	default:
+		return
+	}
+	if !w.Remove(id) {
+		return
+	}
+	select {
+	case <-ctx.Done():
+	case w.results <- result:
+	}
+}
+
+// indexOf returns the index of the id in the watched jobs or -1, w.mu must be held.
+func (w *Watcher) indexOf(id string) int {
+	for i, watched := range w.ids {
+		if watched == id {
+			return i
+		}
+	}
+	return -1
+}
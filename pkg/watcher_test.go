@@ -0,0 +1,278 @@
+package assemblyai
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// watchAll runs the watcher until the expected number of results was received and checks that no result is delivered twice.
+func watchAll(t *testing.T, watcher *Watcher, expected int) map[string]WatchResult {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
+	results := map[string]WatchResult{}
+	timeout := time.After(time.Second * 5)
+	for len(results) < expected {
+		select {
+		case result := <-watcher.Results():
+			_, duplicate := results[result.Id]
+			assert.False(t, duplicate, "result of %s delivered twice", result.Id)
+			results[result.Id] = result
+		case <-timeout:
+			t.Fatalf("received %d of %d results", len(results), expected)
+		}
+	}
+	// later ticks must not deliver a result again
+	time.Sleep(time.Millisecond * 30)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	for result := range watcher.Results() {
+		t.Errorf("unexpected result of %s", result.Id)
+	}
+	return results
+}
+
+func TestWatcher(t *testing.T) {
+	polls := map[string]int{"job-1": 1, "job-2": 3, "job-3": 5, "job-4": -1}
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		id := strings.TrimPrefix(req.URL.Path, "/transcript/")
+		mu.Lock()
+		requests[id]++
+		count := requests[id]
+		mu.Unlock()
+		needed, ok := polls[id]
+		switch {
+		case !ok:
+			res.WriteHeader(404)
+			res.Write([]byte(`{"error": "Transcript not found"}`))
+		case needed < 0:
+			res.WriteHeader(200)
+			res.Write([]byte(`{"id": "` + id + `", "status": "error", "error": "Download error"}`))
+		case count < needed:
+			res.WriteHeader(200)
+			res.Write([]byte(`{"id": "` + id + `", "status": "processing"}`))
+		default:
+			res.WriteHeader(200)
+			res.Write([]byte(`{"id": "` + id + `", "status": "completed", "text": "Hello ` + id + `"}`))
+		}
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	watcher := NewWatcher(client, time.Millisecond*5, 2)
+	for _, id := range []string{"job-1", "job-2", "job-3", "job-4", "job-5"} {
+		assert.True(t, watcher.Add(id))
+	}
+	assert.False(t, watcher.Add("job-2"))
+	assert.Equal(t, 5, watcher.Len())
+
+	results := watchAll(t, watcher, 5)
+	for _, id := range []string{"job-1", "job-2", "job-3"} {
+		assert.NoError(t, results[id].Err)
+		assert.Equal(t, "Hello "+id, results[id].Transcript.Text)
+	}
+	assert.EqualError(t, results["job-4"].Err, "Download error")
+	assert.Nil(t, results["job-4"].Transcript)
+	var apiError *APIError
+	assert.ErrorAs(t, results["job-5"].Err, &apiError)
+	assert.Equal(t, 404, apiError.StatusCode)
+	assert.Equal(t, 0, watcher.Len())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[string]int{"job-1": 1, "job-2": 3, "job-3": 5, "job-4": 1, "job-5": 1}, requests)
+}
+
+func TestWatcherConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond * 5)
+		id := strings.TrimPrefix(req.URL.Path, "/transcript/")
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "` + id + `", "status": "completed"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	watcher := NewWatcher(client, time.Millisecond*5, 3)
+	for _, id := range []string{"job-1", "job-2", "job-3", "job-4", "job-5", "job-6", "job-7", "job-8", "job-9", "job-10"} {
+		watcher.Add(id)
+	}
+
+	results := watchAll(t, watcher, 10)
+	assert.Len(t, results, 10)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(3))
+}
+
+func TestWatcherRemove(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		id := strings.TrimPrefix(req.URL.Path, "/transcript/")
+		mu.Lock()
+		requests[id]++
+		count := requests[id]
+		mu.Unlock()
+		status := "processing"
+		if id == "job-2" && count == 3 {
+			status = "completed"
+		}
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "` + id + `", "status": "` + status + `"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	watcher := NewWatcher(client, time.Millisecond*5, 2)
+	watcher.Add("job-1")
+	watcher.Add("job-2")
+	assert.True(t, watcher.Remove("job-1"))
+	assert.False(t, watcher.Remove("job-1"))
+
+	results := watchAll(t, watcher, 1)
+	assert.Contains(t, results, "job-2")
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 0, requests["job-1"])
+}
+
+func TestWatcherAddWhileRunning(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		id := strings.TrimPrefix(req.URL.Path, "/transcript/")
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "` + id + `", "status": "completed"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	watcher := NewWatcher(client, time.Millisecond*5, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
+	watcher.Add("job-1")
+	assert.Equal(t, "job-1", (<-watcher.Results()).Id)
+	watcher.Add("job-2")
+	assert.Equal(t, "job-2", (<-watcher.Results()).Id)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	_, open := <-watcher.Results()
+	assert.False(t, open)
+}
+
+func TestWatcherCancelWithoutReader(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		id := strings.TrimPrefix(req.URL.Path, "/transcript/")
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "` + id + `", "status": "completed"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	watcher := NewWatcher(client, time.Millisecond, 1)
+	for _, id := range []string{"job-1", "job-2", "job-3", "job-4"} {
+		watcher.Add(id)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	assert.ErrorIs(t, watcher.Run(ctx), context.DeadlineExceeded)
+}
+
+func TestWatcherRetriesRateLimited(t *testing.T) {
+	statusCodes := map[string][]int{"job-1": {429, 200}, "job-2": {503, 502, 200}, "job-3": {429, 401}}
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		id := strings.TrimPrefix(req.URL.Path, "/transcript/")
+		mu.Lock()
+		requests[id]++
+		statusCode := statusCodes[id][requests[id]-1]
+		mu.Unlock()
+		res.WriteHeader(statusCode)
+		if statusCode != 200 {
+			res.Write([]byte(`{"error": "Too many requests"}`))
+			return
+		}
+		res.Write([]byte(`{"id": "` + id + `", "status": "completed", "text": "Hello ` + id + `"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	watcher := NewWatcher(client, time.Millisecond*5, 3)
+	for _, id := range []string{"job-1", "job-2", "job-3"} {
+		watcher.Add(id)
+	}
+
+	results := watchAll(t, watcher, 3)
+	assert.NoError(t, results["job-1"].Err)
+	assert.Equal(t, "Hello job-1", results["job-1"].Transcript.Text)
+	assert.NoError(t, results["job-2"].Err)
+	assert.Equal(t, "Hello job-2", results["job-2"].Transcript.Text)
+	assert.ErrorIs(t, results["job-3"].Err, ErrInvalidToken)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[string]int{"job-1": 2, "job-2": 3, "job-3": 2}, requests)
+}
+
+func TestWatcherCancelsRequestsInFlight(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{}, 1)
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		started <- struct{}{}
+		select {
+		case <-release:
+		case <-req.Context().Done():
+		}
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	watcher := NewWatcher(client, time.Millisecond*5, 1)
+	watcher.Add("job-1")
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+	<-started
+	cancel()
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after ctx was cancelled")
+	}
+	_, open := <-watcher.Results()
+	assert.False(t, open)
+	assert.Equal(t, 1, watcher.Len())
+}
+
+func TestNewWatcherDefaults(t *testing.T) {
+	watcher := NewWatcher(New("http://localhost", "some-token", http.DefaultClient), 0, 0)
+	assert.Equal(t, time.Second*5, watcher.frequency)
+	assert.Equal(t, 1, watcher.concurrency)
+}
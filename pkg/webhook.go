@@ -0,0 +1,88 @@
+package assemblyai
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// WebhookPayload is the body AssemblyAI posts to the WebhookURL of a transcription job.
+type WebhookPayload struct {
+	TranscriptID string              `json:"transcript_id"`
+	Status       TranscriptionStatus `json:"status"`
+}
+
+// Parses the webhook request AssemblyAI sends once a transcription job submitted with a WebhookURL is completed or failed.
+// The auth header configured by WebhookAuthHeaderName is not checked.
+// Returns the id and status of the transcription job, use GetTranscript to fetch the result
+func ParseWebhook(r *http.Request) (*WebhookPayload, error) {
+	if r.Method != http.MethodPost {
+		return nil, fmt.Errorf("unexpected webhook method %s", r.Method)
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	var payload WebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.TranscriptID == "" {
+		return nil, errors.New("webhook did not include a transcript_id")
+	}
+	return &payload, nil
+}
+
+// maxWebhookBodySize limits the body of a webhook request read by WebhookHandler.
+const maxWebhookBodySize = 1 << 20
+
+// Verifies the auth header AssemblyAI sends with a webhook request of a job submitted with WebhookAuthHeaderName and WebhookAuthHeaderValue.
+// Multiple secrets allow rotating the secret, e.g. accepting the current and the previous one until all jobs submitted with the previous one are done.
+// The header is compared with every secret in constant time, independent of their lengths, empty secrets never match
+// Returns true if the header matches one of the secrets
+func VerifyWebhookAuth(r *http.Request, headerName string, secrets ...string) bool {
+	value := sha256.Sum256([]byte(r.Header.Get(headerName)))
+	valid := 0
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		expected := sha256.Sum256([]byte(secret))
+		valid |= subtle.ConstantTimeCompare(value[:], expected[:])
+	}
+	return valid == 1
+}
+
+// WebhookHandler returns a handler receiving the webhook requests of AssemblyAI instead of polling the transcription jobs.
+// If secretHeaderName is set, requests without the header matching secretValue are answered with 401,
+// requests which are not a valid webhook are answered with 400. onComplete is only called for valid requests
+func WebhookHandler(secretHeaderName, secretValue string, onComplete func(transcriptID string, status TranscriptionStatus)) http.Handler {
+	return WebhookHandlerWithSecrets(secretHeaderName, []string{secretValue}, onComplete)
+}
+
+// WebhookHandlerWithSecrets returns a handler like WebhookHandler accepting the auth header if it matches one of the secretValues,
+// to rotate the secret without rejecting the webhooks of jobs submitted with the previous one
+func WebhookHandlerWithSecrets(secretHeaderName string, secretValues []string, onComplete func(transcriptID string, status TranscriptionStatus)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if secretHeaderName != "" && !VerifyWebhookAuth(r, secretHeaderName, secretValues...) {
+			http.Error(w, "invalid webhook auth header", http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, fmt.Sprintf("unexpected webhook method %s", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxWebhookBodySize)
+		payload, err := ParseWebhook(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		onComplete(payload.TranscriptID, payload.Status)
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,177 @@
+package assemblyai
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWebhook(t *testing.T) {
+	req := httptest.NewRequest("POST", "/webhook", strings.NewReader(`{
+		"transcript_id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+		"status": "completed"
+	}`))
+
+	payload, err := ParseWebhook(req)
+	assert.NoError(t, err)
+	assert.Equal(t, &WebhookPayload{TranscriptID: "5551722-f677-48a6-9287-39c0aafd9ac1", Status: Completed}, payload)
+}
+
+func TestParseWebhookError(t *testing.T) {
+	req := httptest.NewRequest("POST", "/webhook", strings.NewReader(`{
+		"transcript_id": "5551722-f677-48a6-9287-39c0aafd9ac1",
+		"status": "error"
+	}`))
+
+	payload, err := ParseWebhook(req)
+	assert.NoError(t, err)
+	assert.Equal(t, Err, payload.Status)
+}
+
+func TestParseWebhookInvalid(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		body   string
+	}{
+		"wrong method":   {"GET", ``},
+		"malformed body": {"POST", `{"transcript_id": `},
+		"missing id":     {"POST", `{"status": "completed"}`},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(testCase.method, "/webhook", strings.NewReader(testCase.body))
+			payload, err := ParseWebhook(req)
+			assert.Error(t, err)
+			assert.Nil(t, payload)
+		})
+	}
+}
+
+// webhookCall is a call of the onComplete callback of WebhookHandler.
+type webhookCall struct {
+	transcriptID string
+	status       TranscriptionStatus
+}
+
+func TestWebhookHandler(t *testing.T) {
+	testCases := []struct {
+		name     string
+		method   string
+		header   string
+		body     string
+		code     int
+		expected []webhookCall
+	}{
+		{
+			"completed", "POST", "some-secret",
+			`{"transcript_id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed"}`,
+			200, []webhookCall{{"5551722-f677-48a6-9287-39c0aafd9ac1", Completed}},
+		},
+		{
+			"error", "POST", "some-secret",
+			`{"transcript_id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "error"}`,
+			200, []webhookCall{{"5551722-f677-48a6-9287-39c0aafd9ac1", Err}},
+		},
+		{"missing auth header", "POST", "", `{"transcript_id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed"}`, 401, nil},
+		{"wrong auth header", "POST", "other-secret", `{"transcript_id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed"}`, 401, nil},
+		{"unauthorized malformed body", "POST", "other-secret", `{"transcript_id": `, 401, nil},
+		{"malformed body", "POST", "some-secret", `{"transcript_id": `, 400, nil},
+		{"missing id", "POST", "some-secret", `{"status": "completed"}`, 400, nil},
+		{"wrong method", "GET", "some-secret", ``, 405, nil},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var calls []webhookCall
+			handler := WebhookHandler("X-Webhook-Secret", "some-secret", func(transcriptID string, status TranscriptionStatus) {
+				calls = append(calls, webhookCall{transcriptID, status})
+			})
+			req := httptest.NewRequest(testCase.method, "/webhook", strings.NewReader(testCase.body))
+			if testCase.header != "" {
+				req.Header.Set("X-Webhook-Secret", testCase.header)
+			}
+			res := httptest.NewRecorder()
+
+			handler.ServeHTTP(res, req)
+			assert.Equal(t, testCase.code, res.Code)
+			assert.Equal(t, testCase.expected, calls)
+		})
+	}
+}
+
+func TestWebhookHandlerWithoutSecret(t *testing.T) {
+	var calls []webhookCall
+	server := httptest.NewServer(WebhookHandler("", "", func(transcriptID string, status TranscriptionStatus) {
+		calls = append(calls, webhookCall{transcriptID, status})
+	}))
+	defer server.Close()
+
+	resp, err := http.Post(server.URL, "application/json", strings.NewReader(`{"transcript_id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed"}`))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []webhookCall{{"5551722-f677-48a6-9287-39c0aafd9ac1", Completed}}, calls)
+}
+
+func TestWebhookHandlerBodyTooLarge(t *testing.T) {
+	called := false
+	handler := WebhookHandler("", "", func(transcriptID string, status TranscriptionStatus) {
+		called = true
+	})
+	body := `{"transcript_id": "5551722-f677-48a6-9287-39c0aafd9ac1", "status": "completed", "padding": "` + strings.Repeat("a", maxWebhookBodySize) + `"}`
+	req := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+	res := httptest.NewRecorder()
+
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, 400, res.Code)
+	assert.False(t, called)
+}
+
+func TestVerifyWebhookAuth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		header   string
+		secrets  []string
+		expected bool
+	}{
+		{"matching secret", "current-secret", []string{"current-secret"}, true},
+		{"current secret during rotation", "current-secret", []string{"current-secret", "previous-secret"}, true},
+		{"previous secret during rotation", "previous-secret", []string{"current-secret", "previous-secret"}, true},
+		{"previous secret after rotation", "previous-secret", []string{"current-secret"}, false},
+		{"shorter header", "current", []string{"current-secret"}, false},
+		{"longer header", "current-secret-and-more", []string{"current-secret"}, false},
+		{"missing header", "", []string{"current-secret"}, false},
+		{"missing header with empty secret", "", []string{""}, false},
+		{"no secrets", "current-secret", nil, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/webhook", nil)
+			if testCase.header != "" {
+				req.Header.Set("X-Webhook-Secret", testCase.header)
+			}
+			assert.Equal(t, testCase.expected, VerifyWebhookAuth(req, "X-Webhook-Secret", testCase.secrets...))
+		})
+	}
+}
+
+func TestWebhookHandlerWithSecrets(t *testing.T) {
+	var calls []webhookCall
+	handler := WebhookHandlerWithSecrets("X-Webhook-Secret", []string{"current-secret", "previous-secret"}, func(transcriptID string, status TranscriptionStatus) {
+		calls = append(calls, webhookCall{transcriptID, status})
+	})
+	for _, secret := range []string{"current-secret", "previous-secret", "other-secret"} {
+		req := httptest.NewRequest("POST", "/webhook", strings.NewReader(`{"transcript_id": "`+secret+`", "status": "completed"}`))
+		req.Header.Set("X-Webhook-Secret", secret)
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		if secret == "other-secret" {
+			assert.Equal(t, 401, res.Code)
+		} else {
+			assert.Equal(t, 200, res.Code)
+		}
+	}
+	assert.Equal(t, []webhookCall{{"current-secret", Completed}, {"previous-secret", Completed}}, calls)
+}
@@ -0,0 +1,84 @@
+package assemblyai
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// WordSearchResult contains the matches of every searched word, TotalCount is the sum of all matches.
+type WordSearchResult struct {
+	Id         string            `json:"id"`
+	TotalCount int               `json:"total_count"`
+	Matches    []WordSearchMatch `json:"matches"`
+}
+
+// WordSearchMatch contains every occurrence of a searched word.
+type WordSearchMatch struct {
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+	// Indexes are the positions of the matches in the words of the transcript
+	Indexes []int `json:"indexes"`
+	// Timestamps are the start and end of each match in milliseconds
+	Timestamps []Timestamp `json:"-"`
+}
+
+// ByWord maps the lowercase text of each searched word to its matches.
+func (result *WordSearchResult) ByWord() map[string]WordSearchMatch {
+	matches := make(map[string]WordSearchMatch, len(result.Matches))
+	for _, match := range result.Matches {
+		matches[strings.ToLower(match.Text)] = match
+	}
+	return matches
+}
+
+type wordSearchMatchDto struct {
+	WordSearchMatch
+	Timestamps [][2]int `json:"timestamps"`
+}
+
+type wordSearchResultDto struct {
+	Id         string               `json:"id"`
+	TotalCount int                  `json:"total_count"`
+	Matches    []wordSearchMatchDto `json:"matches"`
+}
+
+// Searches a completed transcription job for words or phrases following the AssemblyAI documentation https://www.assemblyai.com/docs/api-reference/transcript#search-words-in-transcript.
+// Returns the count, the indexes into the words of the transcript and the timestamps of each searched word
+func (client *AssemblyAImpl) WordSearch(id string, words []string) (*WordSearchResult, error) {
+	if len(words) == 0 {
+		return nil, errors.New("word search requires at least one word")
+	}
+	for i, word := range words {
+		if strings.TrimSpace(word) == "" {
+			return nil, fmt.Errorf("word search entry %d is empty", i)
+		}
+		if strings.Contains(word, ",") {
+			return nil, fmt.Errorf("word search entry %d must not contain a comma, got %q", i, word)
+		}
+	}
+	searchUrl := fmt.Sprintf("%s/transcript/%s/word-search?%s", client.baseUrl, id, url.Values{"words": {strings.Join(words, ",")}}.Encode())
+	req, err := client.newRequest("GET", searchUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := getData[wordSearchResultDto](resp)
+	if err != nil {
+		return nil, err
+	}
+	result := &WordSearchResult{Id: data.Id, TotalCount: data.TotalCount, Matches: make([]WordSearchMatch, len(data.Matches))}
+	for i, match := range data.Matches {
+		result.Matches[i] = match.WordSearchMatch
+		result.Matches[i].Timestamps = make([]Timestamp, len(match.Timestamps))
+		for j, timestamp := range match.Timestamps {
+			result.Matches[i].Timestamps[j] = Timestamp{Start: timestamp[0], End: timestamp[1]}
+		}
+	}
+	return result, nil
+}
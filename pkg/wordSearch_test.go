@@ -0,0 +1,137 @@
+package assemblyai
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordSearch(t *testing.T) {
+	content, err := os.ReadFile("testdata/transcript_word_search.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var path, query, authorization string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		query = req.URL.RawQuery
+		authorization = req.Header.Get("authorization")
+		res.WriteHeader(200)
+		res.Write(content)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	result, err := client.WordSearch("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", []string{"webhook", "nano model", "retry"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/transcript/f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90/word-search", path)
+	assert.Equal(t, "words=webhook%2Cnano+model%2Cretry", query)
+	assert.Equal(t, "some-token", authorization)
+	assert.Equal(t, 5, result.TotalCount)
+	assert.Len(t, result.Matches, 3)
+	assert.Equal(t, WordSearchMatch{
+		Text:       "webhook",
+		Count:      2,
+		Indexes:    []int{310, 378},
+		Timestamps: []Timestamp{{Start: 128784, End: 129073}, {Start: 156970, End: 157419}},
+	}, result.Matches[0])
+	assert.Equal(t, []Timestamp{{Start: 102537, End: 103058}}, result.Matches[1].Timestamps)
+}
+
+func TestWordSearchTimestampsMatchWords(t *testing.T) {
+	content, err := os.ReadFile("testdata/transcript_word_search.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write(content)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+	result, err := client.WordSearch("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", []string{"webhook", "retry"})
+	assert.NoError(t, err)
+
+	transcript := getFixtureServer(t, "transcript_words.json")
+	defer transcript.Close()
+	words, err := New(transcript.URL, "some-token", http.DefaultClient).GetWords("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90")
+	assert.NoError(t, err)
+
+	for _, match := range []WordSearchMatch{result.Matches[0], result.Matches[2]} {
+		assert.Len(t, match.Timestamps, match.Count)
+		for i, index := range match.Indexes {
+			assert.Equal(t, Timestamp{Start: words[index].Start, End: words[index].End}, match.Timestamps[i])
+		}
+	}
+}
+
+func TestWordSearchByWord(t *testing.T) {
+	content, err := os.ReadFile("testdata/transcript_word_search.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(200)
+		res.Write(content)
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	result, err := client.WordSearch("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", []string{"Webhook", "nano model", "retry"})
+	assert.NoError(t, err)
+	matches := result.ByWord()
+	assert.Len(t, matches, 3)
+	assert.Equal(t, 2, matches["webhook"].Count)
+	assert.Equal(t, []Timestamp{{Start: 102537, End: 103058}}, matches["nano model"].Timestamps)
+	_, ok := matches["kubernetes"]
+	assert.False(t, ok)
+}
+
+func TestWordSearchEncoding(t *testing.T) {
+	var words string
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		words = req.URL.Query().Get("words")
+		res.WriteHeader(200)
+		res.Write([]byte(`{"id": "f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", "total_count": 0, "matches": []}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.WordSearch("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", []string{"AT&T", "C++", "naïve user", "50%", "what?#"})
+	assert.NoError(t, err)
+	assert.Equal(t, "AT&T,C++,naïve user,50%,what?#", words)
+}
+
+func TestWordSearchInvalidWords(t *testing.T) {
+	called := false
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	_, err := client.WordSearch("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", nil)
+	assert.Error(t, err)
+	_, err = client.WordSearch("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", []string{"webhook", " "})
+	assert.Error(t, err)
+	_, err = client.WordSearch("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", []string{"Smith, John"})
+	assert.ErrorContains(t, err, "comma")
+	assert.False(t, called)
+}
+
+func TestWordSearchNotCompleted(t *testing.T) {
+	server := getServer(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(400)
+		res.Write([]byte(`{"error": "Transcript is not completed yet"}`))
+	})
+	defer server.Close()
+	client := New(server.URL, "some-token", http.DefaultClient)
+
+	result, err := client.WordSearch("f3a9c2d1-6b8e-4f0a-9d27-1c5e8b4a7f90", []string{"webhook"})
+	var apiError *APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 400, apiError.StatusCode)
+	assert.Nil(t, result)
+}